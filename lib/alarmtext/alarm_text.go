@@ -0,0 +1,180 @@
+// Package alarmtext parses the PowerOn alarm text templates held in the "State Alarm Text" and
+// "Supplementary Text" attributes.
+//
+// A template is literal text with directives introduced by '%'. "%%" is a literal '%', any other
+// directive is '%' followed by a single character:
+//
+//	%s  the text for the state (e.g. "OPEN")
+//	%N  the full name of the component
+//	%L  the location part of the name
+//	%C  the circuit part of the name
+//	%P  the plant part of the name
+//
+// Any other directive is unsupported, it renders as nothing and is reported by Render.
+package alarmtext
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SegmentKind int
+
+const (
+	Literal SegmentKind = iota
+	Directive
+)
+
+func (k SegmentKind) String() string {
+	switch k {
+	case Literal:
+		return "Literal"
+	default:
+		return "Directive"
+	}
+}
+
+// The supported directives
+const (
+	DirectiveState    = "%s"
+	DirectiveName     = "%N"
+	DirectiveLocation = "%L"
+	DirectiveCircuit  = "%C"
+	DirectivePlant    = "%P"
+)
+
+// Values are substituted for the supported directives, an empty value renders as nothing
+type Values struct {
+	State    string
+	Name     string
+	Location string
+	Circuit  string
+	Plant    string
+}
+
+func (v Values) directives() map[string]string {
+	return map[string]string{
+		DirectiveState:    v.State,
+		DirectiveName:     v.Name,
+		DirectiveLocation: v.Location,
+		DirectiveCircuit:  v.Circuit,
+		DirectivePlant:    v.Plant,
+	}
+}
+
+// Segment is a piece of literal text or a directive in a template
+type Segment struct {
+	Kind   SegmentKind
+	Text   string // the literal text, or the directive as written (e.g. "%s")
+	Offset int    // byte offset of the segment in the template
+}
+
+// Template is a parsed alarm text template
+type Template struct {
+	Source   string
+	Segments []Segment
+}
+
+// UnsupportedError lists the directives that had no value when rendering a template
+type UnsupportedError struct {
+	Template   string
+	Directives []string
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("unsupported directives %s in alarm text %q", strings.Join(e.Directives, ", "), e.Template)
+}
+
+// Parse splits the template into literal text and directives. Parsing never fails, a trailing '%' is
+// kept as a directive so Render can report it.
+func Parse(text string) *Template {
+	t := &Template{Source: text}
+	var literal strings.Builder
+	literalStart := 0
+	flush := func() {
+		if literal.Len() > 0 {
+			t.Segments = append(t.Segments, Segment{Kind: Literal, Text: literal.String(), Offset: literalStart})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		if text[i] != '%' {
+			if literal.Len() == 0 {
+				literalStart = i
+			}
+			literal.WriteByte(text[i])
+			continue
+		}
+		if i+1 < len(text) && text[i+1] == '%' {
+			if literal.Len() == 0 {
+				literalStart = i
+			}
+			literal.WriteByte('%')
+			i++
+			continue
+		}
+
+		flush()
+		end := min(i+2, len(text))
+		t.Segments = append(t.Segments, Segment{Kind: Directive, Text: text[i:end], Offset: i})
+		i = end - 1
+	}
+	flush()
+	return t
+}
+
+// Directives returns the directives in the template, in the order they appear
+func (t *Template) Directives() []string {
+	var directives []string
+	for _, segment := range t.Segments {
+		if segment.Kind == Directive {
+			directives = append(directives, segment.Text)
+		}
+	}
+	return directives
+}
+
+// UsesName returns true if the template has a name part directive, so callers only look the name up when it is needed
+func (t *Template) UsesName() bool {
+	for _, directive := range t.Directives() {
+		switch directive {
+		case DirectiveName, DirectiveLocation, DirectiveCircuit, DirectivePlant:
+			return true
+		}
+	}
+	return false
+}
+
+// Render substitutes the values for the supported directives. Unsupported directives render as nothing,
+// if there are any the rendered text is still returned along with an *UnsupportedError.
+func (t *Template) Render(values Values) (string, error) {
+	return t.render(values.directives())
+}
+
+func (t *Template) render(values map[string]string) (string, error) {
+	var sb strings.Builder
+	var unsupported []string
+	for _, segment := range t.Segments {
+		if segment.Kind == Literal {
+			sb.WriteString(segment.Text)
+			continue
+		}
+		value, ok := values[segment.Text]
+		if !ok {
+			unsupported = append(unsupported, segment.Text)
+			continue
+		}
+		sb.WriteString(value)
+	}
+
+	if len(unsupported) > 0 {
+		return sb.String(), &UnsupportedError{Template: t.Source, Directives: unsupported}
+	}
+	return sb.String(), nil
+}
+
+// Render parses and renders the template
+func Render(text string, values Values) (string, error) {
+	return Parse(text).Render(values)
+}
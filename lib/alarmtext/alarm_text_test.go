@@ -0,0 +1,44 @@
+package alarmtext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	template := Parse("50%% %s at %L%")
+	assert.Equal(t, []Segment{
+		{Kind: Literal, Text: "50% ", Offset: 0},
+		{Kind: Directive, Text: "%s", Offset: 5},
+		{Kind: Literal, Text: " at ", Offset: 7},
+		{Kind: Directive, Text: "%L", Offset: 11},
+		{Kind: Directive, Text: "%", Offset: 13},
+	}, template.Segments)
+	assert.Equal(t, []string{"%s", "%L", "%"}, template.Directives())
+}
+
+func TestRender(t *testing.T) {
+	values := Values{State: "OPEN", Name: "SUBA, NORTH CB1", Location: "SUBA", Circuit: "NORTH", Plant: "CB1"}
+
+	text, err := Render("%N %s", values)
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, NORTH CB1 OPEN", text)
+
+	text, err = Render("%L %C %P", values)
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA NORTH CB1", text)
+
+	text, err = Render("GAS 50%% %x LOW", Values{})
+	assert.Equal(t, "GAS 50%  LOW", text)
+	var unsupported *UnsupportedError
+	assert.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, []string{"%x"}, unsupported.Directives)
+
+	text, err = Render("NO DIRECTIVES", Values{})
+	assert.NoError(t, err)
+	assert.Equal(t, "NO DIRECTIVES", text)
+
+	assert.True(t, Parse("%s at %L").UsesName())
+	assert.False(t, Parse("%s 50%%").UsesName())
+}
@@ -0,0 +1,38 @@
+package compare
+
+import (
+	"fmt"
+
+	"github.com/3ideas/psasim/lib/alarmtext"
+	"github.com/3ideas/psasim/lib/namerif"
+)
+
+// renderAlarmText renders the component's State Alarm Text for the state text, the name is only looked up if the
+// template uses it. If the template has unsupported directives the text is still returned along with an
+// *alarmtext.UnsupportedError.
+func renderAlarmText(alias string, nameserver namerif.NameService, stateText string) (string, error) {
+	attr, err := nameserver.GetAttributeValue(alias, "State Alarm Text")
+	if err != nil {
+		return "", fmt.Errorf("getting State Alarm Text for %s: %w", alias, err)
+	}
+	template := alarmtext.Parse(attr.Value)
+
+	values := alarmtext.Values{State: stateText}
+	if template.UsesName() {
+		name, err := nameserver.GetName(alias)
+		if err != nil {
+			return "", fmt.Errorf("getting name for %s: %w", alias, err)
+		}
+		values.Name = name.Name
+		if name.Location != nil {
+			values.Location = name.Location.Value
+		}
+		if name.Circuit != nil {
+			values.Circuit = name.Circuit.Value
+		}
+		if name.Plant != nil {
+			values.Plant = name.Plant.Value
+		}
+	}
+	return template.Render(values)
+}
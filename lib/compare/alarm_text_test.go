@@ -0,0 +1,79 @@
+package compare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/3ideas/psasim/lib/alarmtext"
+	"github.com/3ideas/psasim/lib/compdb"
+	"github.com/3ideas/psasim/lib/comps"
+	"github.com/3ideas/psasim/lib/namerif"
+	"github.com/stretchr/testify/assert"
+)
+
+// testNameService answers the attribute and name lookups renderAlarmText makes, counting the name lookups
+type testNameService struct {
+	namerif.NameService
+	alarmText map[string]string
+	names     map[string]*compdb.NameDetails
+	getNames  int
+}
+
+func (s *testNameService) GetAttributeValue(alias, attrName string) (compdb.AttributeValue, error) {
+	value, ok := s.alarmText[alias]
+	if !ok || attrName != "State Alarm Text" {
+		return compdb.AttributeValue{}, fmt.Errorf("no %s for %s", attrName, alias)
+	}
+	return compdb.AttributeValue{Name: attrName, Value: value}, nil
+}
+
+func (s *testNameService) GetName(alias string) (*compdb.NameDetails, error) {
+	s.getNames++
+	return s.names[alias], nil
+}
+
+func (s *testNameService) GetComponentInfo(alias string) (*compdb.ComponentInfo, error) {
+	return nil, fmt.Errorf("no component info for %s", alias)
+}
+
+func TestRenderAlarmText(t *testing.T) {
+	nameserver := &testNameService{
+		alarmText: map[string]string{"SUBA/CB": "%L %P %s", "SUBA/GAS": "GAS 50%% %s %x"},
+		names: map[string]*compdb.NameDetails{"SUBA/CB": {
+			Name:     "SUBA, CB1",
+			Location: &compdb.NamePartResponse{Value: "SUBA"},
+			Circuit:  &compdb.NamePartResponse{},
+			Plant:    &compdb.NamePartResponse{Value: "CB1"},
+		}},
+	}
+
+	text, err := renderAlarmText("SUBA/CB", nameserver, "OPEN")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA CB1 OPEN", text)
+	assert.Equal(t, 1, nameserver.getNames)
+
+	// The name is not looked up when the template does not use it, unsupported directives are reported with the text
+	text, err = renderAlarmText("SUBA/GAS", nameserver, "LOW")
+	assert.Equal(t, "GAS 50% LOW ", text)
+	var unsupported *alarmtext.UnsupportedError
+	assert.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, 1, nameserver.getNames)
+
+	_, err = renderAlarmText("SUBA", nameserver, "OPEN")
+	assert.Error(t, err)
+}
+
+func TestAddActionsAndInfoRendersAlarmText(t *testing.T) {
+	nameserver := &testNameService{alarmText: map[string]string{"SUBA/GAS": "GAS %s%q"}}
+	hierarchy := comps.NewComponentManager("")
+	_, err := hierarchy.CreateComponent("SUBA/GAS", "GAS", "ROOT:SUBA:GAS", nil, "")
+	assert.NoError(t, err)
+
+	comparison := NewAlarmsComparison()
+	comparison.AddAlarm(&AlarmCompare{POAlias: "SUBA/GAS", PToken5: "LOW"})
+	comparison.AddActionsAndInfo(hierarchy, nameserver)
+
+	alarm := comparison.Alarms()[0]
+	assert.Equal(t, "GAS LOW", alarm.POAlarmTextRendered)
+	assert.Contains(t, alarm.AlarmTextError, "%q")
+}
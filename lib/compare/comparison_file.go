@@ -76,6 +76,8 @@ type AlarmCompare struct {
 	ComponentTemplateID    string `csv:"ComponentTemplateID"`
 	ComponentTemplateName  string `csv:"ComponentTemplateName"`
 	ComponentNameRule      string `csv:"ComponentNameRule"`
+	POAlarmTextRendered    string `csv:"POAlarmTextRendered"` // State Alarm Text rendered for the PO state (ptoken5)
+	AlarmTextError         string `csv:"AlarmTextError"`
 	*alarmstatetext.ScadaAttributes
 }
 
@@ -209,6 +211,10 @@ func (a *AlarmsComparison) AddActionsAndInfo(comps *comps.ComponentManager, name
 				clonePathname = compInfo.ClonePathname
 				nameRule = compInfo.NameRule
 			}
+			alarm.POAlarmTextRendered, err = renderAlarmText(comp.Alias, nameserver, alarm.PToken5)
+			if err != nil {
+				alarm.AlarmTextError = err.Error()
+			}
 			scadaAttr, err := alarmstatetext.GetScadaAttributes(comp.Alias, nameserver, alarm.PointID, alarm.Value, alarm.EToken5, alarm.PToken5, alarm.Type)
 			if err == nil {
 				alarm.ScadaAttributes = scadaAttr
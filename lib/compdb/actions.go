@@ -13,22 +13,53 @@ type AttributeNameValue struct {
 }
 
 func (n *ComponentDb) RenameComponent(alias, newName string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.renameComponent(alias, newName)
+}
+
+func (n *ComponentDb) renameComponent(alias, newName string) error {
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return fmt.Errorf("error getting component %s: %w", alias, err)
 	}
+	if c := n.nameConstraints(); c != nil {
+		if err := c.ValidatePathname(alias, newName); err != nil {
+			return err
+		}
+	}
+	depth, done := n.startNameCheck()
+	defer done()
 	oldName := comp.ComponentPathname
+	n.Components.removePathIndex(comp)
 	comp.ComponentPathname = newName
+	n.Components.addPathIndex(comp)
+	if comp.Parent != nil {
+		comp.Parent.SortChildren()
+	}
+	n.refreshDependentNames(comp)
 
 	slog.Info("Namer: Rename", "alias", alias, "oldName", oldName, "newName", newName)
 
 	// Push operation to rollback stack
-	n.rollbackStack = append(n.rollbackStack, RollbackOperation{RenameComponentAction, alias, oldName})
+	n.pushRollback(RollbackOperation{RenameComponentAction, alias, oldName})
 
-	return nil
+	return n.checkGeneratedNames(comp, depth)
 }
 
 func (n *ComponentDb) MoveComponent(alias, newLocationAlias string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.moveComponent(alias, newLocationAlias)
+}
+
+func (n *ComponentDb) moveComponent(alias, newLocationAlias string) error {
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return fmt.Errorf("error getting component %s: %w", alias, err)
@@ -40,21 +71,49 @@ func (n *ComponentDb) MoveComponent(alias, newLocationAlias string) error {
 		return fmt.Errorf("error getting component %s: %w", newLocationAlias, err)
 	}
 
+	if err := n.checkMove(comp, newLocation); err != nil {
+		return err
+	}
+
 	slog.Info("Namer: Move", "alias", alias, "newLocationAlias", newLocationAlias)
+	depth, done := n.startNameCheck()
+	defer done()
 
-	comp.ComponentParentID = newLocation.ComponentID
+	if comp.OriginalParent == nil {
+		comp.OriginalParent = comp.Parent
+	}
+	n.Components.removePathIndex(comp)
+	n.Components.setParent(comp, newLocation)
+	n.Components.addPathIndex(comp)
+	n.refreshSubtreeNames(comp)
 
 	// Push operation to rollback stack
-	n.rollbackStack = append(n.rollbackStack, RollbackOperation{MoveComponentAction, alias, oldParentID})
+	n.pushRollback(RollbackOperation{MoveComponentAction, alias, oldParentID})
 
-	return nil
+	return n.checkGeneratedNames(comp, depth)
 }
 
 func (n *ComponentDb) CreateAttribute(alias, attrName, attrValue string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.createAttribute(alias, attrName, attrValue, "")
+}
+
+// createAttribute creates or updates the attribute, a created attribute is given attrID or a new ID if it is empty
+func (n *ComponentDb) createAttribute(alias, attrName, attrValue, attrID string) error {
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return fmt.Errorf("error getting component %s: %w", alias, err)
 	}
+	if err := n.validateCircuitName(alias, attrName, attrValue); err != nil {
+		return err
+	}
+	depth, done := n.startNameCheck()
+	defer done()
+	redoStack := n.redoStack
 	attr, err := n.GetComponentAttribute(comp.ComponentID, attrName)
 	if err == nil {
 		if attr.AttributeValue == attrValue {
@@ -65,37 +124,51 @@ func (n *ComponentDb) CreateAttribute(alias, attrName, attrValue string) error {
 
 		oldValue := attr.AttributeValue
 		attr.AttributeValue = attrValue
+		n.Attributes.AddAttribute(attr) // keep attributes looked up on demand in memory so the change is not lost from the cache
 
 		// Push operation to rollback stack
-		n.rollbackStack = append(n.rollbackStack, RollbackOperation{UpdateAttributeAction, alias, AttributeNameValue{Name: attrName, Value: oldValue}})
+		n.pushRollback(RollbackOperation{UpdateAttributeAction, alias, AttributeNameValue{Name: attrName, Value: oldValue}})
 		slog.Info("CreateAttribute: Update", "alias", alias, "attrName", attrName, "oldValue", oldValue, "newValue", attrValue)
 	} else {
 		// Generate uniq ID for the attribute
-		attrID := uuid.New().String()
+		if attrID == "" {
+			attrID = uuid.New().String()
+		}
 		attr = &Attribute{
 			ComponentID:    comp.ComponentID,
 			AttributeID:    attrID,
 			AttributeName:  attrName,
 			AttributeValue: attrValue,
 		}
-		attrRefID := AttributeID{
-			ComponentID:   comp.ComponentID,
-			AttributeName: attrName,
-		}
-		n.Attributes.attr[attrRefID] = attr
+		n.Attributes.AddAttribute(attr)
 
 		slog.Info("Namer: CreateAttribute", "alias", alias, "attrName", attrName, "newValue", attrValue)
 		// Push operation to rollback stack
-		n.rollbackStack = append(n.rollbackStack, RollbackOperation{CreateAttributeAction, alias, attr})
+		n.pushRollback(RollbackOperation{CreateAttributeAction, alias, attr})
 	}
+	n.refreshDependentNames(comp)
 	// If attrubute name is "Circuit name" then update the component name, this is how PO pfl behaves (really its probably the name of the attribute is in SYS_CIRCUIT_NAME but its hardcoded here)
 	if attrName == "Circuit name" {
-		n.RenameComponent(alias, attrValue)
+		if err := n.renameComponent(alias, attrValue); err != nil {
+			if rollbackErr := n.undoTo(depth, redoStack); rollbackErr != nil {
+				return rollbackErr
+			}
+			return err
+		}
 	}
-	return nil
+	return n.checkGeneratedNames(comp, depth)
 }
 
 func (n *ComponentDb) UpdateAttribute(alias, attrName, attrValue string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.updateAttribute(alias, attrName, attrValue)
+}
+
+func (n *ComponentDb) updateAttribute(alias, attrName, attrValue string) error {
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return fmt.Errorf("error getting component %s: %w", alias, err)
@@ -104,6 +177,12 @@ func (n *ComponentDb) UpdateAttribute(alias, attrName, attrValue string) error {
 	if err != nil {
 		return fmt.Errorf("error getting attribute %s for component %s: %w", attrName, alias, err)
 	}
+	if err := n.validateCircuitName(alias, attrName, attrValue); err != nil {
+		return err
+	}
+	depth, done := n.startNameCheck()
+	defer done()
+	redoStack := n.redoStack
 	if attr.AttributeValue == attrValue {
 		slog.Info("UpdateAttribute: Attribute already exists, with same value, skipping", "alias", alias, "attrName", attrName, "attrValue", attr.AttributeValue, "NewValue", attrValue)
 		return nil
@@ -112,25 +191,62 @@ func (n *ComponentDb) UpdateAttribute(alias, attrName, attrValue string) error {
 
 	oldValue := attr.AttributeValue
 	attr.AttributeValue = attrValue
+	n.Attributes.AddAttribute(attr) // keep attributes looked up on demand in memory so the change is not lost from the cache
 
 	// Push operation to rollback stack
-	n.rollbackStack = append(n.rollbackStack, RollbackOperation{UpdateAttributeAction, alias, AttributeNameValue{Name: attrName, Value: oldValue}})
+	n.pushRollback(RollbackOperation{UpdateAttributeAction, alias, AttributeNameValue{Name: attrName, Value: oldValue}})
 	slog.Info("Namer: CreateAttribute Update", "alias", alias, "attrName", attrName, "oldValue", oldValue, "newValue", attrValue)
+	n.refreshDependentNames(comp)
 
 	if attrName == "Circuit name" { // If attrubute name is "Circuit name" then update the component name, this is how PO pfl behaves (really its probably the name of the attribute is in SYS_CIRCUIT_NAME but its hardcoded here)
-		n.RenameComponent(alias, attrValue)
+		if err := n.renameComponent(alias, attrValue); err != nil {
+			if rollbackErr := n.undoTo(depth, redoStack); rollbackErr != nil {
+				return rollbackErr
+			}
+			return err
+		}
 	}
 
+	return n.checkGeneratedNames(comp, depth)
+}
+
+// validateCircuitName checks the pathname a "Circuit name" attribute renames the component to
+func (n *ComponentDb) validateCircuitName(alias, attrName, attrValue string) error {
+	if attrName != "Circuit name" {
+		return nil
+	}
+	if c := n.nameConstraints(); c != nil {
+		return c.ValidatePathname(alias, attrValue)
+	}
 	return nil
 }
 
 func (n *ComponentDb) CreateComponentReturnComponent(alias, name, parentAlias, templateAlias, substationClassName string) (*Component, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return nil, err
+	}
+	return n.createComponentReturnComponent(alias, name, parentAlias, templateAlias, substationClassName, "")
+}
+
+// createComponentReturnComponent creates the component with compID, or a new ID if it is empty
+func (n *ComponentDb) createComponentReturnComponent(alias, name, parentAlias, templateAlias, substationClassName, compID string) (*Component, error) {
 
 	parent, err := n.GetComponent(parentAlias)
 	if err != nil && (parentAlias != "" && len(n.componentsByAlias) > 0) {
 		return nil, fmt.Errorf("error getting component %s: %w", parentAlias, err)
 	}
 
+	if c := n.nameConstraints(); c != nil {
+		if err := c.ValidateAlias(alias); err != nil {
+			return nil, err
+		}
+		if err := c.ValidatePathname(alias, name); err != nil {
+			return nil, err
+		}
+	}
+
 	comp, err := n.GetComponent(alias)
 	if err == nil {
 		slog.Info("Component already exists, skipping", "alias", alias, "name", comp.ComponentPathname, "ID", comp.ComponentID, "parentAlias", parentAlias, "templateAlias", templateAlias, "substationClassName", substationClassName)
@@ -141,7 +257,9 @@ func (n *ComponentDb) CreateComponentReturnComponent(alias, name, parentAlias, t
 	substationClass, _ := GetSubstationClassFromName(substationClassName) // Ignore the error as it will return NotApplicable if the name is not found
 
 	// Generate a new component ID
-	compID := uuid.New().String()
+	if compID == "" {
+		compID = uuid.New().String()
+	}
 
 	var newComp Component
 
@@ -153,32 +271,166 @@ func (n *ComponentDb) CreateComponentReturnComponent(alias, name, parentAlias, t
 		newComp = *template
 	}
 
+	depth, done := n.startNameCheck()
+	defer done()
+
 	// Create a new component with the template
 	newComp.ComponentID = compID
 	newComp.ComponentPathname = name
 	newComp.ComponentAlias = alias
+	newComp.Name = ""
+	newComp.Parent, newComp.OriginalParent, newComp.Children = nil, nil, nil // the copy is placed by AddComponent, not where the template is
 	// newComp.ComponentName = name
 
 	// newComp.ComponentClass = template.ComponentClass
 	newComp.ComponentSubstationClass = substationClass
 
-	parentId := ""
+	parentId, createdUnder := "", ""
 	if parent != nil {
-		parentId = parent.ComponentID
+		parentId, createdUnder = parent.ComponentID, parent.ComponentAlias
 	}
 	newComp.ComponentParentID = parentId
 
 	n.Components.AddComponent(&newComp)
+	n.refreshNames([]*Component{&newComp})
 
 	slog.Info("Namer: CreateNewComp", "alias", alias, "name", name, "ID", newComp.ComponentID, "ParentID", newComp.ComponentParentID, "ParentAlias", parentAlias, "templateAlias", templateAlias, "substationClassName", substationClassName)
 
 	// Push operation to rollback stack
-	n.rollbackStack = append(n.rollbackStack, RollbackOperation{CreateComponentAction, alias, &newComp}) // TODO: change name of operation to CreateComponent
+	n.pushRollback(RollbackOperation{CreateComponentAction, alias, &createdComponent{comp: &newComp, parentAlias: createdUnder, templateAlias: templateAlias}})
 
+	if err := n.checkGeneratedNames(&newComp, depth); err != nil {
+		return nil, err
+	}
 	return &newComp, nil
 }
 
 func (n *ComponentDb) CreateComponent(alias, name, parentAlias, templateAlias, substationClassName string) error {
-	_, err := n.CreateComponentReturnComponent(alias, name, parentAlias, templateAlias, substationClassName)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.createComponent(alias, name, parentAlias, templateAlias, substationClassName)
+}
+
+func (n *ComponentDb) createComponent(alias, name, parentAlias, templateAlias, substationClassName string) error {
+	_, err := n.createComponentReturnComponent(alias, name, parentAlias, templateAlias, substationClassName, "")
 	return err
 }
+
+// createdComponent is the rollback state of CreateComponent
+type createdComponent struct {
+	comp          *Component
+	parentAlias   string // the parent it was created under, it may have been moved since
+	templateAlias string // the component it was copied from, empty if none
+}
+
+// deletedComponent is the rollback state of DeleteComponent
+type deletedComponent struct {
+	comp       *Component   // the deleted component, its Children still hold the deleted subtree
+	parent     *Component   // the parent it was deleted from
+	subtree    []*Component // the component and all its children
+	attributes []*Attribute // the attributes of the subtree that were in memory
+}
+
+// DeleteComponent removes the component. A component with children is only removed, with all its children,
+// if recursive is set.
+func (n *ComponentDb) DeleteComponent(alias string, recursive bool) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.deleteComponent(alias, recursive)
+}
+
+func (n *ComponentDb) deleteComponent(alias string, recursive bool) error {
+	comp, err := n.GetComponent(alias)
+	if err != nil {
+		return fmt.Errorf("error getting component %s: %w", alias, err)
+	}
+	if comp == n.Components.Root || comp.Parent == nil {
+		return fmt.Errorf("can not delete the root component %s", alias)
+	}
+	if len(comp.Children) > 0 && !recursive {
+		return fmt.Errorf("component %s has %d children, delete recursively to remove them", alias, len(comp.Children))
+	}
+
+	deleted := &deletedComponent{comp: comp, parent: comp.Parent, subtree: []*Component{comp}}
+	for _, childAlias := range comp.GetAllChildernAliases() {
+		if child, ok := n.componentsByAlias[childAlias]; ok {
+			deleted.subtree = append(deleted.subtree, child)
+		}
+	}
+
+	for _, c := range deleted.subtree {
+		deleted.attributes = append(deleted.attributes, n.Attributes.deleteComponentAttributes(c.ComponentID)...)
+	}
+
+	n.Components.removePathIndex(comp)
+	for _, c := range deleted.subtree {
+		n.forgetName(c)
+		delete(n.componentsByAlias, c.ComponentAlias)
+		delete(n.componentsByID, c.ComponentID)
+	}
+	comp.Parent.RemoveChild(comp)
+	comp.Parent = nil
+
+	slog.Info("Namer: DeleteComponent", "alias", alias, "components", len(deleted.subtree), "attributes", len(deleted.attributes))
+
+	// Push operation to rollback stack
+	n.pushRollback(RollbackOperation{DeleteComponentAction, alias, deleted})
+
+	return nil
+}
+
+// restoreSubtree puts a deleted component, its children and their attributes back
+func (n *ComponentDb) restoreSubtree(deleted *deletedComponent) {
+	for _, c := range deleted.subtree {
+		n.componentsByAlias[c.ComponentAlias] = c
+		n.componentsByID[c.ComponentID] = c
+	}
+	for _, attr := range deleted.attributes {
+		n.Attributes.AddAttribute(attr)
+	}
+	n.Components.setParent(deleted.comp, deleted.parent)
+	n.Components.addPathIndex(deleted.comp)
+	n.refreshNames(deleted.subtree)
+}
+
+// DeleteAttribute removes the attribute from the component
+func (n *ComponentDb) DeleteAttribute(alias, attrName string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.checkSessionChanges(); err != nil {
+		return err
+	}
+	return n.deleteAttribute(alias, attrName)
+}
+
+func (n *ComponentDb) deleteAttribute(alias, attrName string) error {
+	comp, err := n.GetComponent(alias)
+	if err != nil {
+		return fmt.Errorf("error getting component %s: %w", alias, err)
+	}
+	attr, err := n.GetComponentAttribute(comp.ComponentID, attrName)
+	if err != nil {
+		return fmt.Errorf("error getting attribute %s for component %s: %w", attrName, alias, err)
+	}
+
+	attrID := AttributeID{ComponentID: comp.ComponentID, AttributeName: attrName}
+	n.Attributes.DeleteAttribute(comp.ComponentID, attrName)
+	if n.Attributes.deleted == nil {
+		n.Attributes.deleted = make(map[AttributeID]int)
+	}
+	n.Attributes.deleted[attrID]++
+	n.refreshDependentNames(comp)
+
+	slog.Info("Namer: DeleteAttribute", "alias", alias, "attrName", attrName, "oldValue", attr.AttributeValue)
+
+	// Push operation to rollback stack
+	n.pushRollback(RollbackOperation{DeleteAttributeAction, alias, attr})
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package compdb
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -205,3 +207,338 @@ func TestRollbackToPoint(t *testing.T) {
 	assert.Equal(t, "NewName", comp.ComponentPathname)            // should still be unchanged
 
 }
+
+func TestSavepointsAndRedo(t *testing.T) {
+	localNamer := NewCompDb()
+
+	localNamer.CreateComponent("ROOT", "ROOT", "", "", "")
+	localNamer.CreateComponent("comp1", "comp1", "ROOT", "", "")
+	localNamer.CreateComponent("comp2", "comp2", "ROOT", "", "")
+
+	assert.NoError(t, localNamer.SetSavepoint("outer"))
+	assert.Error(t, localNamer.SetSavepoint("outer"))
+	assert.NoError(t, localNamer.RenameComponent("comp1", "Renamed"))
+	assert.NoError(t, localNamer.SetSavepoint("inner"))
+	assert.NoError(t, localNamer.MoveComponent("comp1", "comp2"))
+	assert.NoError(t, localNamer.CreateAttribute("comp1", "attr1", "value1"))
+
+	// Rolling back to the inner savepoint keeps the outer changes
+	assert.NoError(t, localNamer.RollbackToSavepoint("inner"))
+	comp, _ := localNamer.GetComponent("comp1")
+	root, _ := localNamer.GetComponent("ROOT")
+	comp2, _ := localNamer.GetComponent("comp2")
+	assert.Equal(t, "Renamed", comp.ComponentPathname)
+	assert.Equal(t, root, comp.Parent)
+	noOfRedos, _ := localNamer.GetNumberOfRedos()
+	assert.Equal(t, 2, noOfRedos)
+
+	// Redo replays the undone changes in order
+	assert.NoError(t, localNamer.Redo())
+	assert.Equal(t, comp2, comp.Parent)
+	assert.NoError(t, localNamer.Redo())
+	attr, err := localNamer.GetComponentAttribute(comp.ComponentID, "attr1")
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", attr.AttributeValue)
+	assert.Error(t, localNamer.Redo())
+
+	// A new change clears the redo stack
+	assert.NoError(t, localNamer.Rollback())
+	assert.NoError(t, localNamer.CreateAttribute("comp1", "attr2", "value2"))
+	noOfRedos, _ = localNamer.GetNumberOfRedos()
+	assert.Equal(t, 0, noOfRedos)
+
+	// Rolling back to the outer savepoint removes the inner one
+	assert.NoError(t, localNamer.RollbackToSavepoint("outer"))
+	assert.Equal(t, "comp1", comp.ComponentPathname)
+	savepoints, _ := localNamer.Savepoints()
+	assert.Equal(t, []Savepoint{{Name: "outer", Changes: 3}}, savepoints)
+	assert.Error(t, localNamer.RollbackToSavepoint("inner"))
+
+	// Redo recreates a rolled back component
+	assert.NoError(t, localNamer.CreateComponent("comp3", "comp3", "comp2", "", ""))
+	assert.NoError(t, localNamer.Rollback())
+	_, err = localNamer.GetComponent("comp3")
+	assert.Error(t, err)
+	assert.NoError(t, localNamer.Redo())
+	comp3, err := localNamer.GetComponent("comp3")
+	assert.NoError(t, err)
+	assert.Equal(t, comp2, comp3.Parent)
+
+	assert.NoError(t, localNamer.ReleaseSavepoint("outer"))
+	savepoints, _ = localNamer.Savepoints()
+	assert.Empty(t, savepoints)
+	assert.Error(t, localNamer.ReleaseSavepoint("outer"))
+}
+
+func TestSessions(t *testing.T) {
+	localNamer := NewCompDb()
+
+	localNamer.CreateComponent("ROOT", "ROOT", "", "", "")
+	localNamer.CreateComponent("comp1", "comp1", "ROOT", "", "")
+	localNamer.CreateComponent("comp2", "comp2", "ROOT", "", "")
+	comp1, _ := localNamer.GetComponent("comp1")
+	comp2, _ := localNamer.GetComponent("comp2")
+
+	session1, err := localNamer.OpenSession()
+	assert.NoError(t, err)
+	session2, err := localNamer.OpenSession()
+	assert.NoError(t, err)
+
+	// Each session only sees its own changes
+	assert.NoError(t, localNamer.WithSession(session1, func() error {
+		assert.NoError(t, localNamer.SetSavepoint("start"))
+		assert.NoError(t, localNamer.RenameComponent("comp1", "Session1"))
+		return localNamer.CreateAttribute("comp1", "attr1", "value1")
+	}))
+	assert.NoError(t, localNamer.WithSession(session2, func() error {
+		assert.Equal(t, "comp1", comp1.ComponentPathname)
+		_, err := localNamer.GetAttributeValue("comp1", "attr1")
+		assert.Error(t, err)
+		// The shared changes can not be rolled back from a session
+		assert.Error(t, localNamer.RollbackAll())
+		return localNamer.MoveComponent("comp1", "comp2")
+	}))
+	assert.NoError(t, localNamer.WithSession("", func() error {
+		assert.Equal(t, "comp1", comp1.ComponentPathname)
+		assert.NotEqual(t, comp2, comp1.Parent)
+		noOfChanges, _ := localNamer.GetNumberOfChanges()
+		assert.Equal(t, 3, noOfChanges)
+		return nil
+	}))
+
+	// Switching back replays the session's changes, with its own rollback and savepoints
+	assert.NoError(t, localNamer.WithSession(session1, func() error {
+		assert.Equal(t, "Session1", comp1.ComponentPathname)
+		assert.NotEqual(t, comp2, comp1.Parent)
+		value, err := localNamer.GetAttributeValue("comp1", "attr1")
+		assert.NoError(t, err)
+		assert.Equal(t, "value1", value.Value)
+		noOfChanges, _ := localNamer.GetNumberOfChanges()
+		assert.Equal(t, 2, noOfChanges)
+		savepoints, _ := localNamer.Savepoints()
+		assert.Equal(t, []Savepoint{{Name: "start", Changes: 0}}, savepoints)
+		return localNamer.Rollback()
+	}))
+	assert.NoError(t, localNamer.WithSession(session2, func() error {
+		assert.Equal(t, comp2, comp1.Parent)
+		return nil
+	}))
+	assert.NoError(t, localNamer.WithSession(session1, func() error {
+		_, err := localNamer.GetAttributeValue("comp1", "attr1")
+		assert.Error(t, err)
+		noOfRedos, _ := localNamer.GetNumberOfRedos()
+		assert.Equal(t, 1, noOfRedos)
+		return localNamer.RollbackToSavepoint("start")
+	}))
+
+	// Closing a session discards its changes, idle sessions expire
+	assert.NoError(t, localNamer.CloseSession(session2))
+	assert.ErrorIs(t, localNamer.WithSession(session2, func() error { return nil }), ErrUnknownSession)
+	assert.NotEqual(t, comp2, comp1.Parent)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, []string{session1}, localNamer.ExpireSessions(5*time.Millisecond))
+	assert.ErrorIs(t, localNamer.CloseSession(session1), ErrUnknownSession)
+	noOfChanges, _ := localNamer.GetNumberOfChanges()
+	assert.Equal(t, 3, noOfChanges)
+}
+
+func TestSessionReplay(t *testing.T) {
+	localNamer := NewCompDb()
+	localNamer.CreateComponent("ROOT", "ROOT", "", "", "")
+	session, err := localNamer.OpenSession()
+	assert.NoError(t, err)
+
+	// Components and attributes created in a session keep their IDs when the session is replayed
+	var compID, attrID string
+	assert.NoError(t, localNamer.WithSession(session, func() error {
+		comp, err := localNamer.CreateComponentReturnComponent("comp1", "comp1", "ROOT", "", "")
+		assert.NoError(t, err)
+		compID = comp.ComponentID
+		assert.NoError(t, localNamer.CreateAttribute("comp1", "attr1", "value1"))
+		value, _ := localNamer.GetAttributeValue("comp1", "attr1")
+		attrID = value.ID
+		return nil
+	}))
+	assert.NoError(t, localNamer.WithSession("", func() error {
+		_, err := localNamer.GetComponentInfoByID(compID)
+		assert.Error(t, err)
+		return nil
+	}))
+	assert.NoError(t, localNamer.WithSession(session, func() error {
+		info, err := localNamer.GetComponentInfoByID(compID)
+		assert.NoError(t, err)
+		assert.Equal(t, "comp1", info.Alias)
+		value, _ := localNamer.GetAttributeValue("comp1", "attr1")
+		assert.Equal(t, attrID, value.ID)
+
+		// and when redone
+		assert.NoError(t, localNamer.RollbackAll())
+		assert.NoError(t, localNamer.Redo())
+		assert.NoError(t, localNamer.Redo())
+		info, err = localNamer.GetComponentInfoByID(compID)
+		assert.NoError(t, err)
+		assert.Equal(t, "comp1", info.Alias)
+		return nil
+	}))
+
+	// A shared change that conflicts with the session's changes stops the session being used, without
+	// losing its changes, until the shared change is rolled back
+	assert.NoError(t, localNamer.WithSession("", func() error {
+		return localNamer.CreateComponent("comp1", "shared", "ROOT", "", "")
+	}))
+	err = localNamer.WithSession(session, func() error { return nil })
+	assert.ErrorIs(t, err, ErrSessionConflict)
+	assert.NoError(t, localNamer.WithSession("", func() error {
+		comp, _ := localNamer.GetComponent("comp1")
+		assert.Equal(t, "shared", comp.ComponentPathname)
+		return localNamer.Rollback()
+	}))
+	assert.NoError(t, localNamer.WithSession(session, func() error {
+		info, err := localNamer.GetComponentInfoByID(compID)
+		assert.NoError(t, err)
+		assert.Equal(t, "comp1", info.Alias)
+		noOfChanges, _ := localNamer.GetNumberOfChanges()
+		assert.Equal(t, 2, noOfChanges)
+		return nil
+	}))
+}
+
+func TestSessionChangeLimit(t *testing.T) {
+	localNamer := NewCompDb()
+	localNamer.SetMaxSessionChanges(50)
+	localNamer.CreateComponent("ROOT", "ROOT", "", "", "")
+	sessions := make([]string, 3)
+	for i := range sessions {
+		localNamer.CreateComponent(fmt.Sprintf("comp%d", i), fmt.Sprintf("comp%d", i), "ROOT", "", "")
+		localNamer.CreateAttribute(fmt.Sprintf("comp%d", i), "attr", "shared")
+		var err error
+		sessions[i], err = localNamer.OpenSession()
+		assert.NoError(t, err)
+	}
+
+	// The sessions take turns to change their own component until they have all the changes they can
+	for round := 0; round < 50; round++ {
+		for i, session := range sessions {
+			assert.NoError(t, localNamer.WithSession(session, func() error {
+				alias := fmt.Sprintf("comp%d", i)
+				value, _ := localNamer.GetAttributeValue(alias, "attr")
+				if round == 0 {
+					assert.Equal(t, "shared", value.Value)
+				} else {
+					assert.Equal(t, fmt.Sprintf("session%d round%d", i, round-1), value.Value)
+				}
+				return localNamer.UpdateAttribute(alias, "attr", fmt.Sprintf("session%d round%d", i, round))
+			}))
+		}
+	}
+
+	// Another change in a full session is rejected and changes nothing, the other sessions see none of them
+	assert.NoError(t, localNamer.WithSession(sessions[0], func() error {
+		assert.ErrorIs(t, localNamer.UpdateAttribute("comp0", "attr", "too many"), ErrSessionFull)
+		assert.ErrorIs(t, localNamer.RenameComponent("comp0", "too many"), ErrSessionFull)
+		value, _ := localNamer.GetAttributeValue("comp0", "attr")
+		assert.Equal(t, "session0 round49", value.Value)
+		noOfChanges, _ := localNamer.GetNumberOfChanges()
+		assert.Equal(t, 50, noOfChanges)
+
+		// until a change is rolled back
+		assert.NoError(t, localNamer.Rollback())
+		return localNamer.RenameComponent("comp0", "renamed")
+	}))
+	assert.NoError(t, localNamer.WithSession(sessions[1], func() error {
+		comp0, _ := localNamer.GetComponent("comp0")
+		assert.Equal(t, "comp0", comp0.ComponentPathname)
+		value, _ := localNamer.GetAttributeValue("comp0", "attr")
+		assert.Equal(t, "shared", value.Value)
+		value, _ = localNamer.GetAttributeValue("comp1", "attr")
+		assert.Equal(t, "session1 round49", value.Value)
+		return nil
+	}))
+
+	// The shared changes are not limited
+	assert.NoError(t, localNamer.WithSession("", func() error {
+		for round := 0; round < 60; round++ {
+			assert.NoError(t, localNamer.UpdateAttribute("comp2", "attr", fmt.Sprintf("shared round%d", round)))
+		}
+		return nil
+	}))
+}
+
+func TestDeleteComponentAndAttribute(t *testing.T) {
+	n := newTestNamer(t)
+	n.ResolveNames()
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	circuit, _ := n.GetComponent("SUBA/132_CCT/W1")
+	name := circuit.Name
+	assert.Equal(t, "SUBA SUB, NORTH W1", name)
+
+	// A component with children is only deleted recursively, the root never
+	assert.Error(t, n.DeleteComponent("SUBA/132_CCT/W1", false))
+	assert.Error(t, n.DeleteComponent("ROOT", true))
+	assert.Equal(t, 0, len(n.rollbackStack))
+
+	assert.NoError(t, n.DeleteComponent("SUBA/132_CCT/W1", true))
+	_, err := n.GetComponent("SUBA/132_CCT/W1")
+	assert.Error(t, err)
+	_, err = n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Error(t, err)
+	_, ok := n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.False(t, ok)
+	_, err = n.GetComponentAttribute("2", "Circuit Name")
+	assert.Error(t, err)
+	assert.NotContains(t, n.Attributes.byComponent, "2")
+	matches, err := n.FindComponentsByName(name, false, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+	sub, _ := n.GetComponent("SUBA")
+	assert.Empty(t, sub.Children)
+
+	lines, err := n.ChangeScript()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"echo SUBA/132_CCT/W1", "delete_comp SUBA/132_CCT/W1"}, lines)
+
+	// Rollback restores the subtree, its attributes and the indexes
+	assert.NoError(t, n.Rollback())
+	restored, err := n.GetComponent("SUBA/132_CCT/W1")
+	assert.NoError(t, err)
+	assert.Equal(t, circuit, restored)
+	assert.Equal(t, sub, circuit.Parent)
+	assert.Contains(t, sub.Children, circuit)
+	restored, ok = n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.True(t, ok)
+	assert.Equal(t, cb, restored)
+	assert.Equal(t, name, circuit.Name)
+	attr, err := n.GetComponentAttribute("2", "Circuit Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "NORTH", attr.AttributeValue)
+	assert.Equal(t, map[string]*Attribute{"Circuit Name": attr}, n.Attributes.byComponent["2"])
+	matches, err = n.FindComponentsByName(name, false, 0)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+
+	// Deleting an attribute changes the names that use it until it is rolled back
+	assert.Error(t, n.DeleteAttribute("SUBA/132_CCT/W1", "Missing"))
+	assert.NoError(t, n.DeleteAttribute("SUBA/132_CCT/W1", "Circuit Name"))
+	_, err = n.GetComponentAttribute("2", "Circuit Name")
+	assert.Error(t, err)
+	assert.Equal(t, "SUBA SUB, W1 CCT", circuit.Name)
+	lines, err = n.ChangeScript()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"echo SUBA/132_CCT/W1", `delete_attr SUBA/132_CCT/W1 "Circuit Name"`}, lines)
+
+	assert.NoError(t, n.Rollback())
+	attr, err = n.GetComponentAttribute("2", "Circuit Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "NORTH", attr.AttributeValue)
+	assert.Equal(t, name, circuit.Name)
+
+	// Preview reports the deleted components without deleting them
+	impacts, err := n.PreviewChanges([]Change{{Action: DeleteComponentAction, Alias: "SUBA/132_CCT/W1", Recursive: true}})
+	assert.NoError(t, err)
+	assert.Len(t, impacts, 2)
+	for _, impact := range impacts {
+		assert.True(t, impact.Deleted)
+	}
+	_, err = n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+}
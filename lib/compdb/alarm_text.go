@@ -0,0 +1,20 @@
+package compdb
+
+import (
+	"strings"
+
+	"github.com/3ideas/psasim/lib/alarmtext"
+)
+
+// isAlarmTextAttribute returns true if the attribute holds an alarm text template
+func isAlarmTextAttribute(attributeName string) bool {
+	return attributeName == "State Alarm Text" || attributeName == "Supplementary Text"
+}
+
+// renderNameAlarmText renders an alarm text template used as part of a name. There is no state and the name
+// parts are the ones being generated, so the directives render as nothing and the space they leave at either end
+// is trimmed. Unsupported directives are returned as the error along with the text.
+func renderNameAlarmText(template string) (string, error) {
+	text, err := alarmtext.Render(template, alarmtext.Values{})
+	return strings.TrimSpace(text), err
+}
@@ -0,0 +1,154 @@
+package compdb
+
+import (
+	"container/list"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	// ErrAttributeNotFound is returned when the component does not have the attribute
+	ErrAttributeNotFound = errors.New("attribute not found")
+	// ErrAttributeNotLoaded is returned when the attribute was not preloaded and could not be looked up in the database
+	ErrAttributeNotLoaded = errors.New("attribute not loaded")
+)
+
+// DefaultAttributeCacheSize is the number of attributes looked up on demand that are cached
+const DefaultAttributeCacheSize = 100000
+
+// LoadOptions control what LoadCompDbWithOptions reads from the database
+type LoadOptions struct {
+	AttributeNames     []string // attributes preloaded for every component, defaults to DefaultAttributeNames
+	AttributeCacheSize int      // attributes looked up on demand that are cached, 0 disables on demand lookups
+	Patch              int      // component patch to overlay on the base model, AllPatches for all of them, 0 for none
+}
+
+// DefaultLoadOptions returns the options used by LoadCompDb
+func DefaultLoadOptions() LoadOptions {
+	return LoadOptions{AttributeNames: DefaultAttributeNames, AttributeCacheSize: DefaultAttributeCacheSize}
+}
+
+// attributeColumns are the columns of COMPONENT_ATTRIBUTES read for an attribute looked up on demand
+const attributeColumns = `
+	COALESCE(COMPONENT_ID, '') AS COMPONENT_ID,
+	COALESCE(ATTRIBUTE_NAME, '') AS ATTRIBUTE_NAME,
+	COALESCE(ATTRIBUTE_ID, '') AS ATTRIBUTE_ID,
+	COALESCE(ATTRIBUTE_INDEX, 0) AS ATTRIBUTE_INDEX,
+	COALESCE(ATTRIBUTE_VALUE, '') AS ATTRIBUTE_VALUE,
+	COALESCE(ATTRIBUTE_TYPE, '') AS ATTRIBUTE_TYPE,
+	COALESCE(ATTRIBUTE_DE_TYPE, '') AS ATTRIBUTE_DE_TYPE,
+	COALESCE(ATTRIBUTE_ALARM_REF, '') AS ATTRIBUTE_ALARM_REF,
+	COALESCE(ATTRIBUTE_STATUS, '') AS ATTRIBUTE_STATUS,
+	COALESCE(ATTRIBUTE_ALARM_INDEX, 0) AS ATTRIBUTE_ALARM_INDEX,
+	COALESCE(ATTRIBUTE_DEFINITION, '') AS ATTRIBUTE_DEFINITION`
+
+// attributeLoader looks up attributes that were not preloaded in the database, keeping the most recently used in a bounded cache.
+// Absent attributes are cached too so repeated misses do not query the database.
+type attributeLoader struct {
+	db        *sqlx.DB
+	preloaded map[string]bool
+	size      int
+
+	mu      sync.Mutex // the loader is used by the name resolution workers
+	entries map[AttributeID]*list.Element
+	order   *list.List // of *attributeCacheEntry, most recently used at the front
+}
+
+type attributeCacheEntry struct {
+	id   AttributeID
+	attr *Attribute // nil if the component does not have the attribute
+}
+
+func newAttributeLoader(db *sqlx.DB, attributeNames []string, size int) *attributeLoader {
+	preloaded := make(map[string]bool, len(attributeNames))
+	for _, name := range attributeNames {
+		preloaded[name] = true
+	}
+	return &attributeLoader{
+		db:        db,
+		preloaded: preloaded,
+		size:      size,
+		entries:   make(map[AttributeID]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// onDemand returns true if attributes that were not preloaded are looked up in the database
+func (l *attributeLoader) onDemand() bool {
+	return l != nil && l.db != nil && l.size > 0
+}
+
+// getAttribute returns an attribute that is not in the preloaded attributes
+func (l *attributeLoader) getAttribute(id AttributeID) (*Attribute, error) {
+	if l.preloaded[id.AttributeName] {
+		return nil, fmt.Errorf("%w: %s for component %s", ErrAttributeNotFound, id.AttributeName, id.ComponentID)
+	}
+	if l.db == nil || l.size <= 0 {
+		return nil, fmt.Errorf("%w: %s for component %s, it is not in the preloaded attributes", ErrAttributeNotLoaded, id.AttributeName, id.ComponentID)
+	}
+
+	if attr, ok := l.getCached(id); ok {
+		if attr == nil {
+			return nil, fmt.Errorf("%w: %s for component %s", ErrAttributeNotFound, id.AttributeName, id.ComponentID)
+		}
+		return attr, nil
+	}
+
+	var attr Attribute
+	err := l.db.Get(&attr, `SELECT `+attributeColumns+` FROM COMPONENT_ATTRIBUTES WHERE COMPONENT_ID = ? AND ATTRIBUTE_NAME = ?`,
+		id.ComponentID, id.AttributeName)
+	if errors.Is(err, sql.ErrNoRows) {
+		l.addCached(id, nil)
+		return nil, fmt.Errorf("%w: %s for component %s", ErrAttributeNotFound, id.AttributeName, id.ComponentID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for component %s: %w", ErrAttributeNotLoaded, id.AttributeName, id.ComponentID, err)
+	}
+	l.addCached(id, &attr)
+	return &attr, nil
+}
+
+// getAttributes returns every component's value of an attribute that is not in the preloaded attributes, keyed by
+// component ID, with a single query. The cache is not used, this is for reading the attribute of many components.
+func (l *attributeLoader) getAttributes(attributeName string) (map[string]*Attribute, error) {
+	var attrs []*Attribute
+	if err := l.db.Select(&attrs, `SELECT `+attributeColumns+` FROM COMPONENT_ATTRIBUTES WHERE ATTRIBUTE_NAME = ?`, attributeName); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrAttributeNotLoaded, attributeName, err)
+	}
+	byComponent := make(map[string]*Attribute, len(attrs))
+	for _, attr := range attrs {
+		byComponent[attr.ComponentID] = attr
+	}
+	return byComponent, nil
+}
+
+func (l *attributeLoader) getCached(id AttributeID) (*Attribute, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	element, ok := l.entries[id]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(element)
+	return element.Value.(*attributeCacheEntry).attr, true
+}
+
+func (l *attributeLoader) addCached(id AttributeID, attr *Attribute) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if element, ok := l.entries[id]; ok {
+		element.Value.(*attributeCacheEntry).attr = attr
+		l.order.MoveToFront(element)
+		return
+	}
+	l.entries[id] = l.order.PushFront(&attributeCacheEntry{id: id, attr: attr})
+	for l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*attributeCacheEntry).id)
+	}
+}
@@ -0,0 +1,49 @@
+package compdb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributeLoader(t *testing.T) {
+	db, err := sqlx.Open("sqlite", filepath.Join(t.TempDir(), "attrs.db"))
+	assert.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE COMPONENT_ATTRIBUTES (COMPONENT_ID TEXT, ATTRIBUTE_ID TEXT, ATTRIBUTE_NAME TEXT, ATTRIBUTE_INDEX INTEGER, ATTRIBUTE_DEFINITION TEXT,
+		ATTRIBUTE_VALUE TEXT, ATTRIBUTE_TYPE TEXT, ATTRIBUTE_DE_TYPE TEXT, ATTRIBUTE_ALARM_REF TEXT, ATTRIBUTE_STATUS TEXT, ATTRIBUTE_ALARM_INDEX INTEGER)`)
+	assert.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO COMPONENT_ATTRIBUTES (COMPONENT_ID, ATTRIBUTE_ID, ATTRIBUTE_NAME, ATTRIBUTE_VALUE) VALUES
+		('3', 'A1', 'Feeder Number', '42'), ('2', 'A2', 'Feeder Number', '7')`)
+	assert.NoError(t, err)
+
+	n := newTestNamer(t)
+	n.attrLoader = newAttributeLoader(db, []string{"Circuit Name"}, 1)
+
+	attr, err := n.GetComponentAttribute("3", "Feeder Number")
+	assert.NoError(t, err)
+	assert.Equal(t, "42", attr.AttributeValue)
+
+	_, err = n.GetComponentAttribute("3", "Circuit Name")
+	assert.ErrorIs(t, err, ErrAttributeNotFound)
+	_, err = n.GetComponentAttribute("1", "Feeder Number")
+	assert.ErrorIs(t, err, ErrAttributeNotFound)
+
+	// The cache only holds one attribute
+	attr, err = n.GetComponentAttribute("2", "Feeder Number")
+	assert.NoError(t, err)
+	assert.Equal(t, "7", attr.AttributeValue)
+	assert.Equal(t, 1, n.attrLoader.order.Len())
+
+	// Changes to attributes looked up on demand are kept once they leave the cache
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W1/CB", "Feeder Number", "43"))
+	_, _ = n.GetComponentAttribute("1", "Feeder Number")
+	attr, err = n.GetComponentAttribute("3", "Feeder Number")
+	assert.NoError(t, err)
+	assert.Equal(t, "43", attr.AttributeValue)
+
+	n.attrLoader = newAttributeLoader(db, []string{"Circuit Name"}, 0)
+	_, err = n.GetComponentAttribute("2", "Feeder Number")
+	assert.ErrorIs(t, err, ErrAttributeNotLoaded)
+}
@@ -0,0 +1,229 @@
+package compdb
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// scriptCommand is a net change from the rollback stack, seq orders the commands in the script
+type scriptCommand struct {
+	seq   int
+	alias string
+	line  string
+}
+
+// scriptCommands are the script commands that replay each RollbackAction. The only PowerOn script command
+// used in this repo is get_comp_desc (see compare.GenerateNameQueryScript), these are not PowerOn commands.
+// Each is named after the action it replays, in the same form: the verb, then "comp" for a Component or
+// "attr" for an Attribute. A new RollbackAction needs a command here.
+var scriptCommands = map[RollbackAction]string{
+	CreateComponentAction: "create_comp",
+	DeleteComponentAction: "delete_comp",
+	RenameComponentAction: "rename_comp",
+	MoveComponentAction:   "move_comp",
+	CreateAttributeAction: "create_attr",
+	UpdateAttributeAction: "update_attr",
+	DeleteAttributeAction: "delete_attr",
+}
+
+// ChangeScript returns the changes made since the database was loaded as a script, in the style of the
+// get_comp_desc scripts written by compare.GenerateNameQueryScript, with the commands in scriptCommands:
+//
+//	create_comp <alias> <pathname> <parent alias> <template alias> <substation class>
+//	delete_comp <alias>
+//	rename_comp <alias> <pathname>
+//	move_comp <alias> <parent alias>
+//	create_attr <alias> <attribute> <value>
+//	update_attr <alias> <attribute> <value>
+//	delete_attr <alias> <attribute>
+//
+// Only the net effect of the changes is written. A component, or attribute, changed more than once has one
+// command with its final value, changes back to the original value are dropped and the renames of created
+// components are folded into the create_comp. delete_comp removes the component and its children, so no
+// commands are written for components that no longer exist. Commands are ordered by the last change they
+// include, apart from create_comp which is ordered by the creation, with the parent the component was created
+// under, so every component a command uses exists when it runs. A created component that has since been moved
+// is followed by a move_comp, and one created and deleted is only written if a component was created under it.
+func (n *ComponentDb) ChangeScript() ([]string, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	type compChange struct {
+		seq      int
+		original string // pathname or parent ID before the first change
+	}
+	type attrChange struct {
+		seq      int
+		alias    string
+		existed  bool   // the attribute existed before the first change
+		original string // value before the first change
+	}
+	type createChange struct {
+		seq       int
+		created   *createdComponent
+		parent    *createChange // the create of the parent it was created under, nil if the parent existed
+		deleteSeq int           // position of the delete, -1 if it was not deleted
+		needed    bool          // created and deleted, but a component that is kept was created under it
+	}
+
+	creates := make([]*createChange, 0)
+	createdByAlias := make(map[string]*createChange) // alias -> the create of the component that has it
+	deleted := make(map[string]int)                  // alias -> position of the delete
+	renames := make(map[string]*compChange)
+	moves := make(map[string]*compChange)
+	attrs := make(map[AttributeID]*attrChange)
+
+	for seq, op := range n.rollbackStack[n.sessionBase:] { // in a session only its own changes
+		switch op.Action {
+		case CreateComponentAction:
+			created := op.OldState.(*createdComponent)
+			create := &createChange{seq: seq, created: created, parent: createdByAlias[created.parentAlias], deleteSeq: -1}
+			creates = append(creates, create)
+			createdByAlias[op.Alias] = create
+		case DeleteComponentAction:
+			if create, ok := createdByAlias[op.Alias]; ok {
+				create.deleteSeq = seq // created and deleted, there is nothing to do unless it is needed
+				delete(createdByAlias, op.Alias)
+			} else {
+				deleted[op.Alias] = seq
+			}
+		case RenameComponentAction:
+			if change, ok := renames[op.Alias]; ok {
+				change.seq = seq
+			} else {
+				renames[op.Alias] = &compChange{seq: seq, original: op.OldState.(string)}
+			}
+		case MoveComponentAction:
+			if change, ok := moves[op.Alias]; ok {
+				change.seq = seq
+			} else {
+				moves[op.Alias] = &compChange{seq: seq, original: op.OldState.(string)}
+			}
+		case CreateAttributeAction, DeleteAttributeAction:
+			attr := op.OldState.(*Attribute)
+			id := AttributeID{ComponentID: attr.ComponentID, AttributeName: attr.AttributeName}
+			if change, ok := attrs[id]; ok {
+				change.seq = seq
+			} else {
+				attrs[id] = &attrChange{seq: seq, alias: op.Alias, existed: op.Action == DeleteAttributeAction, original: attr.AttributeValue}
+			}
+		case UpdateAttributeAction:
+			comp, err := n.GetComponent(op.Alias)
+			if err != nil {
+				continue // the component has since been deleted
+			}
+			nameValue := op.OldState.(AttributeNameValue)
+			id := AttributeID{ComponentID: comp.ComponentID, AttributeName: nameValue.Name}
+			if change, ok := attrs[id]; ok {
+				change.seq = seq
+			} else {
+				attrs[id] = &attrChange{seq: seq, alias: op.Alias, existed: true, original: nameValue.Value}
+			}
+		}
+	}
+
+	// A kept component is created under its parent at the time, so a parent that was later deleted is needed
+	kept := func(create *createChange) bool {
+		return create.deleteSeq < 0 && n.componentsByID[create.created.comp.ComponentID] == create.created.comp
+	}
+	for _, create := range creates {
+		if !kept(create) {
+			continue
+		}
+		for parent := create.parent; parent != nil && parent.deleteSeq >= 0 && !parent.needed; parent = parent.parent {
+			parent.needed = true
+		}
+	}
+
+	commands := make([]scriptCommand, 0)
+	for alias, seq := range deleted {
+		commands = append(commands, scriptCommand{seq, alias, scriptLine(scriptCommands[DeleteComponentAction], alias)})
+	}
+	for _, create := range creates {
+		if !kept(create) && !create.needed {
+			continue // deleted, or deleted with its parent
+		}
+		comp := create.created.comp
+		commands = append(commands, scriptCommand{create.seq, comp.ComponentAlias, scriptLine(scriptCommands[CreateComponentAction], comp.ComponentAlias, comp.ComponentPathname, create.created.parentAlias, create.created.templateAlias, comp.ComponentSubstationClass.String())})
+		if create.needed {
+			commands = append(commands, scriptCommand{create.deleteSeq, comp.ComponentAlias, scriptLine(scriptCommands[DeleteComponentAction], comp.ComponentAlias)})
+		}
+	}
+	for alias, change := range renames {
+		if _, ok := createdByAlias[alias]; ok {
+			continue // folded into the create
+		}
+		comp, err := n.GetComponent(alias)
+		if err != nil {
+			continue
+		}
+		if comp.ComponentPathname != change.original {
+			commands = append(commands, scriptCommand{change.seq, alias, scriptLine(scriptCommands[RenameComponentAction], alias, comp.ComponentPathname)})
+		}
+	}
+	for alias, change := range moves {
+		comp, err := n.GetComponent(alias)
+		if err != nil {
+			continue
+		}
+		if comp.ComponentParentID != change.original {
+			commands = append(commands, scriptCommand{change.seq, alias, scriptLine(scriptCommands[MoveComponentAction], alias, parentAlias(comp))})
+		}
+	}
+	for id, change := range attrs {
+		if _, ok := n.componentsByID[id.ComponentID]; !ok {
+			continue
+		}
+		attr, err := n.GetComponentAttribute(id.ComponentID, id.AttributeName)
+		exists := err == nil
+		switch {
+		case change.existed && !exists:
+			commands = append(commands, scriptCommand{change.seq, change.alias, scriptLine(scriptCommands[DeleteAttributeAction], change.alias, id.AttributeName)})
+		case !change.existed && exists:
+			commands = append(commands, scriptCommand{change.seq, change.alias, scriptLine(scriptCommands[CreateAttributeAction], change.alias, id.AttributeName, attr.AttributeValue)})
+		case exists && attr.AttributeValue != change.original:
+			commands = append(commands, scriptCommand{change.seq, change.alias, scriptLine(scriptCommands[UpdateAttributeAction], change.alias, id.AttributeName, attr.AttributeValue)})
+		}
+	}
+
+	sort.SliceStable(commands, func(i, j int) bool {
+		return commands[i].seq < commands[j].seq
+	})
+	lines := make([]string, 0, 2*len(commands))
+	for _, command := range commands {
+		lines = append(lines, "echo "+command.alias, command.line)
+	}
+	return lines, nil
+}
+
+// WriteChangeScript writes the lines of a script returned by ChangeScript to a file
+func WriteChangeScript(filename string, lines []string) error {
+	var script strings.Builder
+	for _, line := range lines {
+		script.WriteString(line + "\n")
+	}
+	if err := os.WriteFile(filename, []byte(script.String()), 0644); err != nil {
+		return fmt.Errorf("error writing change script to %s: %w", filename, err)
+	}
+	return nil
+}
+
+func parentAlias(comp *Component) string {
+	if comp.Parent != nil {
+		return comp.Parent.ComponentAlias
+	}
+	return ""
+}
+
+// scriptLine joins the command and its arguments, quoting arguments that are empty or contain spaces
+func scriptLine(command string, args ...string) string {
+	parts := []string{command}
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"") {
+			arg = `"` + strings.ReplaceAll(arg, `"`, `\"`) + `"`
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
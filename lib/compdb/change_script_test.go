@@ -0,0 +1,122 @@
+package compdb
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeScript(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBB", "SUBB", "0", 1, PrimarySubstation)
+	n.ResolveNames()
+
+	assert.NoError(t, n.RenameComponent("SUBA/132_CCT/W1/CB", "CB9"))
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "SOUTH"))
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "NORTH")) // back to the original, dropped
+	assert.NoError(t, n.CreateComponent("SUBB/CB", "CB2", "SUBB", "SUBA/132_CCT/W1/CB", "Primary Substation Component"))
+	assert.NoError(t, n.RenameComponent("SUBB/CB", "CB3")) // folded into the create
+	assert.NoError(t, n.CreateAttribute("SUBB/CB", "Plant", "NEW BKR"))
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBB"))
+	assert.NoError(t, n.RenameComponent("SUBA/132_CCT/W1/CB", "CB8"))
+
+	lines, err := n.ChangeScript()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"echo SUBB/CB",
+		`create_comp SUBB/CB CB3 SUBB SUBA/132_CCT/W1/CB "Primary Substation Component"`,
+		"echo SUBB/CB",
+		`create_attr SUBB/CB Plant "NEW BKR"`,
+		"echo SUBA/132_CCT/W1",
+		"move_comp SUBA/132_CCT/W1 SUBB",
+		"echo SUBA/132_CCT/W1/CB",
+		"rename_comp SUBA/132_CCT/W1/CB CB8",
+	}, lines)
+
+	assert.NoError(t, n.RollbackAll())
+	lines, err = n.ChangeScript()
+	assert.NoError(t, err)
+	assert.Empty(t, lines)
+}
+
+func TestChangeScriptCreateOrder(t *testing.T) {
+	n := newTestNamer(t)
+	n.ResolveNames()
+
+	// A component moved under one created after it is created under its first parent, then moved
+	assert.NoError(t, n.CreateComponent("SUBA/X", "X", "SUBA", "", ""))
+	assert.NoError(t, n.CreateComponent("SUBA/P", "P", "SUBA", "", ""))
+	assert.NoError(t, n.MoveComponent("SUBA/X", "SUBA/P"))
+	// One created under a component that is deleted is kept, so is the component it was created under
+	assert.NoError(t, n.CreateComponent("SUBA/Q", "Q", "SUBA", "", ""))
+	assert.NoError(t, n.CreateComponent("SUBA/Y", "Y", "SUBA/Q", "", ""))
+	assert.NoError(t, n.MoveComponent("SUBA/Y", "SUBA"))
+	assert.NoError(t, n.DeleteComponent("SUBA/Q", false))
+	// A rolled back create does not leave its template behind
+	assert.NoError(t, n.CreateComponent("SUBA/Z", "Z", "SUBA", "SUBA/132_CCT/W1/CB", ""))
+	assert.NoError(t, n.Rollback())
+	assert.NoError(t, n.CreateComponent("SUBA/Z", "Z", "SUBA", "", ""))
+
+	lines, err := n.ChangeScript()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"echo SUBA/X",
+		`create_comp SUBA/X X SUBA "" "Not Applicable"`,
+		"echo SUBA/P",
+		`create_comp SUBA/P P SUBA "" "Not Applicable"`,
+		"echo SUBA/X",
+		"move_comp SUBA/X SUBA/P",
+		"echo SUBA/Q",
+		`create_comp SUBA/Q Q SUBA "" "Not Applicable"`,
+		"echo SUBA/Y",
+		`create_comp SUBA/Y Y SUBA/Q "" "Not Applicable"`,
+		"echo SUBA/Y",
+		"move_comp SUBA/Y SUBA",
+		"echo SUBA/Q",
+		"delete_comp SUBA/Q",
+		"echo SUBA/Z",
+		`create_comp SUBA/Z Z SUBA "" "Not Applicable"`,
+	}, lines)
+}
+
+func TestScriptCommands(t *testing.T) {
+	actions := []RollbackAction{RenameComponentAction, MoveComponentAction, UpdateAttributeAction, CreateAttributeAction, CreateComponentAction, DeleteComponentAction, DeleteAttributeAction}
+	nouns := map[string]string{"Component": "comp", "Attribute": "attr"}
+	actionParts := regexp.MustCompile(`^([A-Z][a-z]+)([A-Z][a-z]+)$`)
+
+	// Each command is named after the action it replays
+	assert.Len(t, scriptCommands, len(actions))
+	for _, action := range actions {
+		parts := actionParts.FindStringSubmatch(string(action))
+		if assert.NotNil(t, parts, action) {
+			assert.Equal(t, strings.ToLower(parts[1])+"_"+nouns[parts[2]], scriptCommands[action], action)
+		}
+	}
+}
+
+func TestWriteChangeScript(t *testing.T) {
+	n := newTestNamer(t)
+	n.ResolveNames()
+	assert.NoError(t, n.RenameComponent("SUBA/132_CCT/W1/CB", "CB9"))
+	assert.NoError(t, n.CreateAttribute("SUBA/132_CCT/W1/CB", "Plant", "NEW BKR"))
+	assert.NoError(t, n.DeleteAttribute("SUBA/132_CCT/W1", "Circuit Name"))
+
+	lines, err := n.ChangeScript()
+	assert.NoError(t, err)
+	filename := filepath.Join(t.TempDir(), "changes.txt")
+	assert.NoError(t, WriteChangeScript(filename, lines))
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo SUBA/132_CCT/W1/CB\n"+
+		"rename_comp SUBA/132_CCT/W1/CB CB9\n"+
+		"echo SUBA/132_CCT/W1/CB\n"+
+		"create_attr SUBA/132_CCT/W1/CB Plant \"NEW BKR\"\n"+
+		"echo SUBA/132_CCT/W1\n"+
+		"delete_attr SUBA/132_CCT/W1 \"Circuit Name\"\n", string(data))
+
+	assert.Error(t, WriteChangeScript(filepath.Join(t.TempDir(), "missing", "changes.txt"), lines))
+}
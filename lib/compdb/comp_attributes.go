@@ -57,25 +57,36 @@ func (a AttributeValue) String() string {
 	return fmt.Sprintf("Name: %s  Value: %s  CompID: %s  AttrID: %s", a.Name, a.Value, a.CompID, a.ID)
 }
 
+// Attributes holds the attributes by ID, and by component so a component's attributes are found without a scan.
+// Attributes are only added and removed through AddAttribute and DeleteAttribute, which keep both up to date.
 type Attributes struct {
-	attr map[AttributeID]*Attribute
+	attr        map[AttributeID]*Attribute
+	byComponent map[string]map[string]*Attribute // by component ID then attribute name
+	deleted     map[AttributeID]int              // attributes deleted by DeleteAttribute, so they are not looked up in the database
 }
 
 func NewAttributeManager() *Attributes {
 	return &Attributes{
-		attr: make(map[AttributeID]*Attribute),
+		attr:        make(map[AttributeID]*Attribute),
+		byComponent: make(map[string]map[string]*Attribute),
 	}
 }
 
 func (a *Attributes) AddAttribute(attr *Attribute) {
 	a.attr[AttributeID{ComponentID: attr.ComponentID, AttributeName: attr.AttributeName}] = attr
+	attrs, ok := a.byComponent[attr.ComponentID]
+	if !ok {
+		attrs = make(map[string]*Attribute)
+		a.byComponent[attr.ComponentID] = attrs
+	}
+	attrs[attr.AttributeName] = attr
 }
 
 func (a *Attributes) GetAttribute(componentID string, attributeName string) (*Attribute, error) {
 	attrID := AttributeID{ComponentID: componentID, AttributeName: attributeName}
 	attr, ok := a.attr[attrID]
 	if !ok {
-		return nil, fmt.Errorf("attribute not found")
+		return nil, fmt.Errorf("%w: %s for component %s", ErrAttributeNotFound, attributeName, componentID)
 	}
 	return attr, nil
 }
@@ -83,15 +94,38 @@ func (a *Attributes) GetAttribute(componentID string, attributeName string) (*At
 func (a *Attributes) DeleteAttribute(componentID string, attributeName string) {
 	attrID := AttributeID{ComponentID: componentID, AttributeName: attributeName}
 	delete(a.attr, attrID)
+	if attrs, ok := a.byComponent[componentID]; ok {
+		delete(attrs, attributeName)
+		if len(attrs) == 0 {
+			delete(a.byComponent, componentID)
+		}
+	}
 }
 
+// deleteComponentAttributes removes all the component's attributes and returns them
+func (a *Attributes) deleteComponentAttributes(componentID string) []*Attribute {
+	attrs := make([]*Attribute, 0, len(a.byComponent[componentID]))
+	for name, attr := range a.byComponent[componentID] {
+		attrs = append(attrs, attr)
+		delete(a.attr, AttributeID{ComponentID: componentID, AttributeName: name})
+	}
+	delete(a.byComponent, componentID)
+	return attrs
+}
+
+// GetComponentAttribute returns the attribute from the preloaded attributes, if the attribute name was not preloaded
+// it is looked up in the database. The error wraps ErrAttributeNotFound if the component does not have the attribute
+// or ErrAttributeNotLoaded if it was not preloaded and could not be looked up.
 func (n *ComponentDb) GetComponentAttribute(componentID string, attributeName string) (*Attribute, error) {
 	attrID := AttributeID{ComponentID: componentID, AttributeName: attributeName}
 	attr, ok := n.Attributes.attr[attrID]
-	if !ok {
-		return nil, fmt.Errorf("attribute not found")
+	if ok {
+		return attr, nil
 	}
-	return attr, nil
+	if n.attrLoader == nil || n.Attributes.deleted[attrID] > 0 {
+		return nil, fmt.Errorf("%w: %s for component %s", ErrAttributeNotFound, attributeName, componentID)
+	}
+	return n.attrLoader.getAttribute(attrID)
 	// var compAttr Attribute
 	// err := n.db.Get(&compAttr, `
 	// 	SELECT
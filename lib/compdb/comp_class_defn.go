@@ -257,7 +257,8 @@ func (c *ComponentClassDefns) GetComponentClassDefnByIndex(componentClassIndex C
 }
 
 func (n *ComponentDb) GetComponentClassDetails(alias string) (*ComponentClassDetails, error) {
-
+	n.mu.RLock()
+	defer n.mu.RUnlock()
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return nil, fmt.Errorf("error getting component: %w", err)
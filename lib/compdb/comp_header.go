@@ -17,7 +17,7 @@ type Component struct {
 	// ComponentLocation         string         `db:"COMPONENT_LOCATION"`
 	ComponentParentID string `db:"COMPONENT_PARENT_ID"`
 	Parent            *Component
-	OriginalParent    *Component
+	OriginalParent    *Component // the parent before the component was first moved, nil if it has not been moved
 	// ComponentSourceID         string         `db:"COMPONENT_SOURCE_ID"`
 	// ComponentDestID           string         `db:"COMPONENT_DEST_ID"`
 	// ComponentConnectClass     string         `db:"COMPONENT_CONNECT_CLASS"`
@@ -26,7 +26,7 @@ type Component struct {
 	// ComponentSwitchStatus     string         `db:"COMPONENT_SWITCH_STATUS"`
 	// ComponentStatus           string         `db:"COMPONENT_STATUS"`
 	// ProtectionLevel           string         `db:"PROTECTION_LEVEL"`
-	// UserReference             string         `db:"USER_REFERENCE"`
+	UserReference string `db:"USER_REFERENCE"`
 	// ComponentType             string         `db:"COMPONENT_TYPE"`
 	// ComponentNormalDressing   string         `db:"COMPONENT_NORMAL_DRESSING"`
 	// ExternalSource            string         `db:"EXTERNAL_SOURCE"`
@@ -109,6 +109,67 @@ func (c *Components) BuildComponentByName() error {
 	return nil
 }
 
+// addToNameIndex adds the component to componentsByName, components without a name are not indexed
+func (c *Components) addToNameIndex(component *Component) {
+	if component.Name == "" {
+		return
+	}
+	if c.componentsByName == nil {
+		c.componentsByName = make(map[string][]*Component)
+	}
+	c.componentsByName[component.Name] = append(c.componentsByName[component.Name], component)
+}
+
+// removeFromNameIndex removes the component from componentsByName under its current name
+func (c *Components) removeFromNameIndex(component *Component) {
+	comps := c.componentsByName[component.Name]
+	for i, comp := range comps {
+		if comp == component {
+			comps = append(comps[:i], comps[i+1:]...)
+			break
+		}
+	}
+	if len(comps) == 0 {
+		delete(c.componentsByName, component.Name)
+	} else {
+		c.componentsByName[component.Name] = comps
+	}
+}
+
+// removePathIndex removes the component and all its children from ByPath.
+// Call before changing anything the full path is built from (pathname or parent)
+func (c *Components) removePathIndex(component *Component) {
+	path := component.GetFullPath()
+	if c.ByPath[path] == component {
+		delete(c.ByPath, path)
+	}
+	for _, child := range component.Children {
+		c.removePathIndex(child)
+	}
+}
+
+// addPathIndex adds the component and all its children to ByPath using their current full paths
+func (c *Components) addPathIndex(component *Component) {
+	c.ByPath[component.GetFullPath()] = component
+	for _, child := range component.Children {
+		c.addPathIndex(child)
+	}
+}
+
+// setParent moves the component under the parent, updating ComponentParentID and both parents Children
+func (c *Components) setParent(component *Component, parent *Component) {
+	if component.Parent != nil {
+		component.Parent.RemoveChild(component)
+	}
+	component.Parent = parent
+	component.ComponentParentID = ""
+	if parent != nil {
+		component.ComponentParentID = parent.ComponentID
+		parent.Children = append(parent.Children, component)
+		parent.SortChildren()
+	}
+}
+
 func (c *Components) RemoveComponent(componentID string) error {
 	comp, ok := c.componentsByID[componentID]
 	if !ok {
@@ -118,7 +179,10 @@ func (c *Components) RemoveComponent(componentID string) error {
 	path := comp.GetFullPath()
 	delete(c.componentsByAlias, comp.ComponentAlias)
 	delete(c.componentsByID, componentID)
-	delete(c.ByPath, path)
+	if c.ByPath[path] == comp {
+		delete(c.ByPath, path)
+	}
+	c.removeFromNameIndex(comp)
 
 	parent, ok := c.componentsByID[comp.ComponentParentID]
 	if !ok {
@@ -194,7 +258,8 @@ func GetComponents(db *sqlx.DB) (*Components, error) {
 		 COALESCE(COMPONENT_CLASS, 0) AS COMPONENT_CLASS, 
 		 COMPONENT_SUBSTATION_CLASS,
 		 COALESCE(COMPONENT_PARENT_ID, '') AS COMPONENT_PARENT_ID,
-		 COALESCE(COMPONENT_CLONE_ID, 0) AS COMPONENT_CLONE_ID
+		 COALESCE(COMPONENT_CLONE_ID, 0) AS COMPONENT_CLONE_ID,
+		 COALESCE(USER_REFERENCE, '') AS USER_REFERENCE
 		 FROM COMPONENT_HEADER WHERE component_patch_number <= 0`)
 	if err != nil {
 		return nil, err
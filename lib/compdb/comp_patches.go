@@ -0,0 +1,225 @@
+package compdb
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/3ideas/psasim/lib/csvutil"
+	"github.com/jmoiron/sqlx"
+)
+
+// AllPatches loads every patch, in patch number order
+const AllPatches = -1
+
+// ComponentPatch is the COMPONENT_HEADER rows of a patch. A row with the ID of a loaded component
+// replaces it, any other row adds a component.
+type ComponentPatch struct {
+	Number     int
+	Components []*Component
+}
+
+type patchComponent struct {
+	Component
+	PatchNumber int `db:"COMPONENT_PATCH_NUMBER"`
+}
+
+// GetComponentPatches returns the patch, or all the patches if patch is AllPatches, ordered by patch number
+func GetComponentPatches(db *sqlx.DB, patch int) ([]*ComponentPatch, error) {
+	query := `
+	SELECT
+		COMPONENT_ID,
+		COALESCE(COMPONENT_PATHNAME, '') AS COMPONENT_PATHNAME,
+		COMPONENT_ALIAS,
+		 COALESCE(COMPONENT_CLASS, 0) AS COMPONENT_CLASS,
+		 COMPONENT_SUBSTATION_CLASS,
+		 COALESCE(COMPONENT_PARENT_ID, '') AS COMPONENT_PARENT_ID,
+		 COALESCE(COMPONENT_CLONE_ID, 0) AS COMPONENT_CLONE_ID,
+		 COALESCE(USER_REFERENCE, '') AS USER_REFERENCE,
+		 COMPONENT_PATCH_NUMBER
+		 FROM COMPONENT_HEADER WHERE component_patch_number > 0`
+	args := []interface{}{}
+	if patch != AllPatches {
+		query += ` AND component_patch_number = ?`
+		args = append(args, patch)
+	}
+	query += ` ORDER BY component_patch_number`
+
+	var rows []*patchComponent
+	if err := db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("error reading component patches: %w", err)
+	}
+
+	patches := make([]*ComponentPatch, 0)
+	for _, row := range rows {
+		if len(patches) == 0 || patches[len(patches)-1].Number != row.PatchNumber {
+			patches = append(patches, &ComponentPatch{Number: row.PatchNumber})
+		}
+		comp := row.Component
+		patches[len(patches)-1].Components = append(patches[len(patches)-1].Components, &comp)
+	}
+	return patches, nil
+}
+
+// PatchChangeType is how a component is changed by a patch
+type PatchChangeType string
+
+const (
+	PatchAdded    PatchChangeType = "Added"    // the patch adds the component
+	PatchModified PatchChangeType = "Modified" // the patch replaces the component
+	PatchAffected PatchChangeType = "Affected" // not in the patch, but its path or name changed
+)
+
+// PatchChange is a row of the patch report, a component whose parent, path or name a patch changes
+type PatchChange struct {
+	Patch     int             `csv:"PATCH"`
+	Alias     string          `csv:"ALIAS"`
+	Change    PatchChangeType `csv:"CHANGE"`
+	OldParent string          `csv:"OLD_PARENT"`
+	NewParent string          `csv:"NEW_PARENT"`
+	OldPath   string          `csv:"OLD_PATH"`
+	NewPath   string          `csv:"NEW_PATH"`
+	OldName   string          `csv:"OLD_NAME"`
+	NewName   string          `csv:"NEW_NAME"`
+}
+
+// patchState is the parent, path and name of a component before a patch
+type patchState struct {
+	parent string
+	path   string
+	name   string
+}
+
+// ApplyComponentPatch overlays the patch on the loaded components and re-resolves the names it affects.
+// Returns the components whose parent, path or name changed, sorted by alias.
+// Patches are applied as part of loading, they are not changes that can be rolled back.
+// A row that would put a component under itself or one of its children is applied without changing the parent.
+func (n *ComponentDb) ApplyComponentPatch(patch *ComponentPatch) []*PatchChange {
+	before := make(map[*Component]*patchState)
+	changeTypes := make(map[*Component]PatchChangeType)
+	patched := make([]*Component, 0, len(patch.Components))
+
+	// Replace the fields of the patched components and add the new ones, then set the parents once
+	// every component in the patch is known as a parent may be added by a later row
+	for _, row := range patch.Components {
+		comp, ok := n.componentsByID[row.ComponentID]
+		if !ok {
+			n.Components.AddComponentNoHierarchy(row)
+			changeTypes[row] = PatchAdded
+			patched = append(patched, row)
+			continue
+		}
+
+		recordPatchState(comp, before)
+		n.Components.removePathIndex(comp)
+		if comp.ComponentAlias != row.ComponentAlias {
+			delete(n.componentsByAlias, comp.ComponentAlias)
+			comp.ComponentAlias = row.ComponentAlias
+			n.componentsByAlias[comp.ComponentAlias] = comp
+		}
+		comp.ComponentPathname = row.ComponentPathname
+		comp.ComponentClass = row.ComponentClass
+		comp.ComponentSubstationClass = row.ComponentSubstationClass
+		comp.ComponentCloneID = row.ComponentCloneID
+		comp.UserReference = row.UserReference
+		comp.ComponentParentID = row.ComponentParentID
+		changeTypes[comp] = PatchModified
+		patched = append(patched, comp)
+	}
+
+	for _, comp := range patched {
+		parent, ok := n.componentsByID[comp.ComponentParentID]
+		if !ok {
+			slog.Error("Patch: no parent found for component", "patch", patch.Number, "alias", comp.ComponentAlias, "parentID", comp.ComponentParentID)
+			continue
+		}
+		if parent == comp.Parent {
+			continue
+		}
+		// A row that puts the component under itself or one of its children keeps the component where it was
+		if err := checkCycle(comp, parent); err != nil {
+			slog.Error("Patch: parent not changed", "patch", patch.Number, "alias", comp.ComponentAlias, "error", err)
+			comp.ComponentParentID = ""
+			if comp.Parent != nil {
+				comp.ComponentParentID = comp.Parent.ComponentID
+			}
+			continue
+		}
+		n.Components.setParent(comp, parent)
+	}
+
+	// The paths and names of the patched components and their children can change
+	refresh := make(map[*Component]bool)
+	for _, comp := range patched {
+		n.Components.addPathIndex(comp)
+		refresh[comp] = true
+		for _, alias := range comp.GetAllChildernAliases() {
+			if child, ok := n.componentsByAlias[alias]; ok {
+				refresh[child] = true
+			}
+		}
+	}
+	comps := make([]*Component, 0, len(refresh))
+	for comp := range refresh {
+		comps = append(comps, comp)
+	}
+	n.refreshNames(comps)
+
+	changes := make([]*PatchChange, 0)
+	for _, comp := range comps {
+		change := &PatchChange{
+			Patch:     patch.Number,
+			Alias:     comp.ComponentAlias,
+			Change:    changeTypes[comp],
+			NewParent: parentAlias(comp),
+			NewPath:   comp.GetFullPath(),
+			NewName:   comp.Name,
+		}
+		if change.Change == "" {
+			change.Change = PatchAffected
+		}
+		if state, ok := before[comp]; ok {
+			change.OldParent, change.OldPath, change.OldName = state.parent, state.path, state.name
+			if change.OldParent == change.NewParent && change.OldPath == change.NewPath && change.OldName == change.NewName {
+				continue
+			}
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Alias < changes[j].Alias
+	})
+
+	slog.Info("Namer: patch applied", "patch", patch.Number, "components", len(patch.Components), "changes", len(changes))
+	return changes
+}
+
+// recordPatchState records the parent, path and name of the component and its children, unless already recorded
+func recordPatchState(comp *Component, before map[*Component]*patchState) {
+	if _, ok := before[comp]; !ok {
+		before[comp] = &patchState{parent: parentAlias(comp), path: comp.GetFullPath(), name: comp.Name}
+	}
+	for _, child := range comp.Children {
+		recordPatchState(child, before)
+	}
+}
+
+// ApplyComponentPatches applies the patches in order, the changes each patch made are kept for PatchReport
+func (n *ComponentDb) ApplyComponentPatches(patches []*ComponentPatch) {
+	for _, patch := range patches {
+		n.patchChanges = append(n.patchChanges, n.ApplyComponentPatch(patch)...)
+	}
+}
+
+// PatchReport returns the changes made by the patches applied when the database was loaded, in patch order
+func (n *ComponentDb) PatchReport() []*PatchChange {
+	return n.patchChanges
+}
+
+// WritePatchReport writes the patch changes to a CSV file
+func WritePatchReport(filename string, changes []*PatchChange) error {
+	if err := csvutil.WriteCSV(filename, changes); err != nil {
+		return fmt.Errorf("error writing patch report to %s: %w", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package compdb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponentPatches(t *testing.T) {
+	db, err := sqlx.Open("sqlite", filepath.Join(t.TempDir(), "patches.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE COMPONENT_HEADER (COMPONENT_ID TEXT, COMPONENT_PATHNAME TEXT, COMPONENT_ALIAS TEXT, COMPONENT_CLASS INTEGER,
+		COMPONENT_SUBSTATION_CLASS INTEGER, COMPONENT_PARENT_ID TEXT, COMPONENT_CLONE_ID TEXT, USER_REFERENCE TEXT, COMPONENT_PATCH_NUMBER INTEGER)`)
+	assert.NoError(t, err)
+	// Patch 1 renames the circuit, patch 2 adds a substation and moves the circuit to it
+	_, err = db.Exec(`INSERT INTO COMPONENT_HEADER VALUES
+		('2', 'W9', 'SUBA/132_CCT/W1', 2, ?, '1', '', '', 1),
+		('5', 'W1', 'SUBB/132_CCT/W1', 2, ?, '4', '', '', 2),
+		('4', 'SUBB', 'SUBB', 1, ?, '0', '', '', 2),
+		('6', 'OLD', 'OLD', 1, ?, '0', '', '', 0)`, PrimaryCircuitID, PrimaryCircuitID, PrimarySubstation, PrimarySubstation)
+	assert.NoError(t, err)
+
+	patches, err := GetComponentPatches(db, AllPatches)
+	assert.NoError(t, err)
+	assert.Len(t, patches, 2)
+	assert.Equal(t, 1, patches[0].Number)
+	assert.Equal(t, 2, patches[1].Number)
+	assert.Len(t, patches[1].Components, 2)
+
+	patches, err = GetComponentPatches(db, 2)
+	assert.NoError(t, err)
+	assert.Len(t, patches, 1)
+
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "2", AttributeName: "Circuit Name", AttributeValue: ""})
+	n.ResolveNames()
+
+	patches, err = GetComponentPatches(db, AllPatches)
+	assert.NoError(t, err)
+	n.ApplyComponentPatches(patches)
+
+	report := n.PatchReport()
+	assert.Equal(t, []*PatchChange{
+		{Patch: 1, Alias: "SUBA/132_CCT/W1", Change: PatchModified, OldParent: "SUBA", NewParent: "SUBA", OldPath: "ROOT:SUBA:W1", NewPath: "ROOT:SUBA:W9", OldName: "SUBA SUB, W1 CCT", NewName: "SUBA SUB, W9 CCT"},
+		{Patch: 1, Alias: "SUBA/132_CCT/W1/CB", Change: PatchAffected, OldParent: "SUBA/132_CCT/W1", NewParent: "SUBA/132_CCT/W1", OldPath: "ROOT:SUBA:W1:CB1", NewPath: "ROOT:SUBA:W9:CB1", OldName: "SUBA, W1 CB1", NewName: "SUBA, W9 CB1"},
+		{Patch: 2, Alias: "SUBB", Change: PatchAdded, NewParent: "ROOT", NewPath: "ROOT:SUBB", NewName: report[2].NewName},
+		{Patch: 2, Alias: "SUBB/132_CCT/W1", Change: PatchAdded, NewParent: "SUBB", NewPath: "ROOT:SUBB:W1", NewName: report[3].NewName},
+	}, report)
+
+	comp, ok := n.GetComponentByPath("ROOT:SUBA:W9:CB1")
+	assert.True(t, ok)
+	assert.Equal(t, "SUBA/132_CCT/W1/CB", comp.ComponentAlias)
+	_, ok = n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.False(t, ok)
+	_, err = n.GetComponent("OLD")
+	assert.Error(t, err, "base rows are not part of a patch")
+}
+
+func TestComponentPatchCycle(t *testing.T) {
+	n := newTestNamer(t)
+	n.ResolveNames()
+
+	// The circuit can not be moved under its own breaker, the rest of the row is applied
+	n.ApplyComponentPatch(&ComponentPatch{Number: 1, Components: []*Component{
+		{ComponentID: "2", ComponentAlias: "SUBA/132_CCT/W1", ComponentPathname: "W9", ComponentClass: 2, ComponentSubstationClass: PrimaryCircuitID, ComponentParentID: "3"},
+	}})
+
+	circuit, _ := n.GetComponent("SUBA/132_CCT/W1")
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Equal(t, "SUBA", circuit.Parent.ComponentAlias)
+	assert.Equal(t, "1", circuit.ComponentParentID)
+	assert.Equal(t, circuit, cb.Parent)
+	assert.Empty(t, cb.Children)
+	_, ok := n.GetComponentByPath("ROOT:SUBA:W9:CB1")
+	assert.True(t, ok)
+}
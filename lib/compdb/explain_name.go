@@ -0,0 +1,165 @@
+package compdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NameTraceStep records the evaluation of a single name rule row
+type NameTraceStep struct {
+	Step                 int
+	Part                 TextLocationType // the part of the name being built
+	RuleName             string           // empty for rules added by the namer (e.g. for a Parent rule)
+	TextIndex            int
+	TextLocation         TextLocationType
+	TextType             TextTypeType
+	Data                 string
+	Data2                string
+	Component            string // alias of the component chosen for the rule, empty if none found
+	Forced               bool   // the component was handed on by a previous force next instruction rule
+	AttributeName        string // attribute looked up, empty if the rule does not use an attribute
+	AttributeFound       bool
+	Fallback             string // description of any fallback taken
+	ForceNext            bool   // the rule forces the next instruction to its component
+	SkippedUseIfNotFound bool   // the rule produced a value but was skipped as the part already had one
+	Value                string // the value added to the name part
+}
+
+// NameExplanation is an ordered trace of every rule evaluated to generate a name
+type NameExplanation struct {
+	Alias    string
+	Name     string
+	RuleName string
+	Steps    []*NameTraceStep
+	Notes    []string
+}
+
+// ExplainName generates the name for the component recording each rule evaluated
+func (n *ComponentDb) ExplainName(alias string) (*NameExplanation, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	trace := &NameExplanation{Alias: alias}
+	name, err := n.getNameFull(alias, trace)
+	if err != nil {
+		return nil, fmt.Errorf("error explaining name for component %s, %w", alias, err)
+	}
+	trace.Name = name.Name
+	trace.RuleName = name.RuleName
+	return trace, nil
+}
+
+func (e *NameExplanation) addStep(part TextLocationType, rule *ComponentNameRule) *NameTraceStep {
+	step := &NameTraceStep{
+		Step:         len(e.Steps) + 1,
+		Part:         part,
+		RuleName:     rule.NameRule,
+		TextIndex:    rule.TextIndex,
+		TextLocation: rule.TextLocation,
+		TextType:     rule.TextType,
+		Data:         rule.Data,
+		Data2:        rule.Data2,
+	}
+	e.Steps = append(e.Steps, step)
+	return step
+}
+
+func (s *NameTraceStep) addFallback(fallback string) {
+	if s.Fallback != "" {
+		s.Fallback += "; "
+	}
+	s.Fallback += fallback
+}
+
+// recordComponent records the component chosen by getComponentForRule (or forced by the previous rule)
+func (s *NameTraceStep) recordComponent(rule *ComponentNameRule, parents []*Component, comp *Component, forced bool) {
+	s.Forced = forced
+	if comp == nil {
+		return
+	}
+	s.Component = comp.ComponentAlias
+
+	if !forced && rule.TextLocation == NameRuleCircuit && !comp.ComponentSubstationClass.IsCircuit() {
+		if rule.UseOriginIfNotFound && comp == parents[0] {
+			s.addFallback("no circuit found, UseOriginIfNotFound used the origin")
+		} else {
+			s.addFallback("no circuit found, UseParentIfNotFound used the parent")
+		}
+	}
+}
+
+// recordValue records the attribute lookup and any fallback from getNameValue
+func (s *NameTraceStep) recordValue(rule *ComponentNameRule, details *NamePartDetails, forceNext bool) {
+	s.ForceNext = forceNext
+
+	if !usesAttribute(rule.TextType) {
+		return
+	}
+	s.AttributeName = rule.Data
+
+	if details == nil {
+		return
+	}
+
+	if details.LocationType == NLAttrubute || details.LocationType == NLAttrubuteOrigin || (details.LocationType == NLVoltage && details.AttributeName != "") {
+		s.AttributeName = details.AttributeName
+		s.AttributeFound = true
+	}
+	if details.Data2Used {
+		s.addFallback("Data attribute not found or empty, used Data2 attribute " + details.AttributeName)
+	}
+	switch details.LocationType {
+	case NLPathName:
+		s.addFallback("attribute not found or empty, used pathname of " + details.Comp.ComponentAlias)
+	case NLClassAbbreviation:
+		s.addFallback("used class abbreviation of " + details.Comp.ComponentAlias)
+	case NLVoltage:
+		if details.AttributeName == "" {
+			s.addFallback("attribute not found or empty, used class voltage of " + details.Comp.ComponentAlias)
+		} else if details.Comp.ComponentAlias != s.Component {
+			s.addFallback("attribute not found or empty, used voltage of ancestor " + details.Comp.ComponentAlias)
+		}
+	}
+}
+
+func (s *NameTraceStep) String() string {
+	ruleName := s.RuleName
+	if ruleName == "" {
+		ruleName = "<added>"
+	}
+	component := s.Component
+	if component == "" {
+		component = "-"
+	}
+	if s.Forced {
+		component += " (forced)"
+	}
+
+	var flags []string
+	if s.AttributeName != "" {
+		flags = append(flags, fmt.Sprintf("Attr: %q found: %t", s.AttributeName, s.AttributeFound))
+	}
+	if s.Fallback != "" {
+		flags = append(flags, "Fallback: "+s.Fallback)
+	}
+	if s.ForceNext {
+		flags = append(flags, "Force next instruction")
+	}
+	if s.SkippedUseIfNotFound {
+		flags = append(flags, "Skipped (UseIfNotFound)")
+	}
+
+	return fmt.Sprintf("%3d %-8s %-15s %3d %-8s %-45s Data: %-20q Comp: %-35s Value: %-20q %s", s.Step, s.Part, ruleName, s.TextIndex, s.TextLocation, s.TextType, s.Data, component, s.Value, strings.Join(flags, ", "))
+}
+
+func (e *NameExplanation) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Alias: %s\nName:  %s\nRule:  %s\n", e.Alias, e.Name, e.RuleName)
+	for _, step := range e.Steps {
+		sb.WriteString(step.String())
+		sb.WriteString("\n")
+	}
+	for _, note := range e.Notes {
+		fmt.Fprintf(&sb, "Note: %s\n", note)
+	}
+	return sb.String()
+}
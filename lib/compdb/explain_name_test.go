@@ -0,0 +1,36 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainName(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Missing"},
+	)
+
+	explanation, err := n.ExplainName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, NORTH CB1", explanation.Name)
+	assert.Equal(t, "TEST CB", explanation.RuleName)
+	assert.Len(t, explanation.Steps, 3)
+
+	circuit := explanation.Steps[1]
+	assert.Equal(t, "SUBA/132_CCT/W1", circuit.Component)
+	assert.Equal(t, "Circuit Name", circuit.AttributeName)
+	assert.True(t, circuit.AttributeFound)
+	assert.Equal(t, "NORTH", circuit.Value)
+
+	plant := explanation.Steps[2]
+	assert.False(t, plant.AttributeFound)
+	assert.Contains(t, plant.Fallback, "used pathname")
+	assert.Equal(t, "CB1", plant.Value)
+
+	_, err = n.ExplainName("MISSING")
+	assert.Error(t, err)
+}
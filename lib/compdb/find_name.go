@@ -0,0 +1,148 @@
+package compdb
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	DefaultNameMatchLimit = 20  // matches returned by FindComponentsByName if no limit is given
+	MinNameMatchScore     = 0.5 // fuzzy matches scoring below this are dropped
+)
+
+// NameMatch is a component found from its generated name
+type NameMatch struct {
+	Alias string
+	Name  string
+	Score float64 // 1 for an exact match, otherwise how close the name is to the one searched for (0-1)
+	Exact bool
+}
+
+// FindComponentsByName returns the components with the generated name. If fuzzy is set names that are
+// close to the name (a partial name, different spacing or case, or a few characters different) are also
+// returned, best match first. At most maxResults matches are returned, DefaultNameMatchLimit if maxResults <= 0.
+func (n *ComponentDb) FindComponentsByName(name string, fuzzy bool, maxResults int) ([]*NameMatch, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if maxResults <= 0 {
+		maxResults = DefaultNameMatchLimit
+	}
+
+	matches := make([]*NameMatch, 0)
+	for _, comp := range n.Components.componentsByName[name] {
+		matches = append(matches, &NameMatch{Alias: comp.ComponentAlias, Name: comp.Name, Score: 1, Exact: true})
+	}
+
+	if fuzzy {
+		queryTokens := nameTokens(name)
+		query := strings.Join(queryTokens, " ")
+		if len(queryTokens) > 0 {
+			for candidate, comps := range n.Components.componentsByName {
+				if candidate == name {
+					continue
+				}
+				score := scoreNameMatch(query, queryTokens, candidate)
+				if score < MinNameMatchScore {
+					continue
+				}
+				for _, comp := range comps {
+					matches = append(matches, &NameMatch{Alias: comp.ComponentAlias, Name: comp.Name, Score: score})
+				}
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].Alias < matches[j].Alias
+	})
+	if len(matches) > maxResults {
+		matches = matches[:maxResults]
+	}
+	return matches, nil
+}
+
+// nameTokens splits a name into upper case words, ignoring punctuation and spacing
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.' && r != '/' && r != '-'
+	})
+}
+
+// scoreNameMatch scores how well the candidate name matches the query (0-1).
+// Most of the score comes from how many of the query words are in the name, so partial names and names
+// with the words in a different order still score well, the rest from the edit distance of the whole name.
+func scoreNameMatch(query string, queryTokens []string, candidate string) float64 {
+	candidateTokens := nameTokens(candidate)
+	tokenScore := 0.0
+	for _, queryToken := range queryTokens {
+		best := 0.0
+		for _, candidateToken := range candidateTokens {
+			if score := scoreToken(queryToken, candidateToken); score > best {
+				best = score
+			}
+		}
+		tokenScore += best
+	}
+	tokenScore /= float64(len(queryTokens))
+	if tokenScore == 0 {
+		return 0
+	}
+
+	normalised := strings.Join(candidateTokens, " ")
+	if normalised == query {
+		return 0.99 // same words, only the spacing, case or punctuation differ
+	}
+	return 0.7*tokenScore + 0.3*similarity(query, normalised)
+}
+
+// scoreToken scores how well a word of the query matches a word of the name
+func scoreToken(queryToken, candidateToken string) float64 {
+	if queryToken == candidateToken {
+		return 1
+	}
+	if strings.HasPrefix(candidateToken, queryToken) {
+		return 0.9
+	}
+	maxDistance := max(1, len(queryToken)/4)
+	distance := levenshtein(queryToken, candidateToken)
+	if distance > maxDistance {
+		return 0
+	}
+	return 1 - float64(distance)/float64(max(len(queryToken), len(candidateToken)))
+}
+
+// similarity returns 1 minus the edit distance between the strings relative to the length of the longer one
+func similarity(a, b string) float64 {
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,57 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindComponentsByName(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBA/132_CCT/W2", "W2", "1", 2, PrimaryCircuitID)
+	addTestComponent(t, n, "5", "SUBA/132_CCT/W2/CB", "CB2", "4", 3, PrimarySubstationComponent)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "4", AttributeName: "Circuit Name", AttributeValue: "SOUTH"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+
+	matches, err := n.FindComponentsByName("SUBA, NORTH CB1", false, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []*NameMatch{{Alias: "SUBA/132_CCT/W1/CB", Name: "SUBA, NORTH CB1", Score: 1, Exact: true}}, matches)
+
+	matches, err = n.FindComponentsByName("suba north cb1", false, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+
+	// Case, spacing and punctuation differences score just below an exact match
+	matches, err = n.FindComponentsByName("suba north cb1", true, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA/132_CCT/W1/CB", matches[0].Alias)
+	assert.Equal(t, 0.99, matches[0].Score)
+	assert.False(t, matches[0].Exact)
+
+	// A misspelt name finds the closest component first
+	matches, err = n.FindComponentsByName("SUBA, SOTH CB2", true, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA/132_CCT/W2/CB", matches[0].Alias)
+	assert.Less(t, matches[0].Score, 1.0)
+	for i := 1; i < len(matches); i++ {
+		assert.LessOrEqual(t, matches[i].Score, matches[i-1].Score)
+	}
+
+	// A partial name matches every component with the words
+	matches, err = n.FindComponentsByName("NORTH", true, 0)
+	assert.NoError(t, err)
+	aliases := []string{}
+	for _, match := range matches {
+		aliases = append(aliases, match.Alias)
+	}
+	assert.ElementsMatch(t, []string{"SUBA/132_CCT/W1", "SUBA/132_CCT/W1/CB"}, aliases)
+
+	matches, err = n.FindComponentsByName("NORTH", true, 1)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
@@ -0,0 +1,61 @@
+package compdb
+
+import "testing"
+
+// newTestNamer builds a small network:
+//
+//	ROOT
+//	  SUBA                  (Primary Substation)
+//	    SUBA/132_CCT/W1     (Primary Circuit ID, "Circuit Name" = "NORTH")
+//	      SUBA/132_CCT/W1/CB (Primary Substation Component, class "Circuit Breaker")
+func newTestNamer(t *testing.T) *ComponentDb {
+	t.Helper()
+	n := NewCompDb()
+
+	addTestClass(n, 1, "Substation", "SUB", "")
+	addTestClass(n, 2, "Circuit", "CCT", "")
+	addTestClass(n, 3, "Circuit Breaker", "CB", "TEST CB")
+
+	root := &Component{ComponentID: "0", ComponentAlias: "ROOT", ComponentPathname: "ROOT"}
+	n.Components.AddComponentNoHierarchy(root)
+	n.Components.Root = root
+
+	addTestComponent(t, n, "1", "SUBA", "SUBA", "0", 1, PrimarySubstation)
+	addTestComponent(t, n, "2", "SUBA/132_CCT/W1", "W1", "1", 2, PrimaryCircuitID)
+	cb := addTestComponent(t, n, "3", "SUBA/132_CCT/W1/CB", "CB1", "2", 3, PrimarySubstationComponent)
+	cb.UserReference = "UR-42"
+
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "2", AttributeName: "Circuit Name", AttributeValue: "NORTH"})
+	return n
+}
+
+func addTestClass(n *ComponentDb, index ComponentClassIndex, name, abbreviation, nameRule string) {
+	classDefn := &ComponentClassDefn{ComponentClassIndex: index, ComponentClassName: name, ComponentAbbreviation: abbreviation, ComponentNameRule: nameRule}
+	n.classDefByIndex[index] = classDefn
+	n.classDefByName[name] = classDefn
+}
+
+func addTestComponent(t testing.TB, n *ComponentDb, id, alias, pathname, parentID string, class ComponentClassIndex, substationClass SubstationType) *Component {
+	t.Helper()
+	comp := &Component{
+		ComponentID:              id,
+		ComponentAlias:           alias,
+		ComponentPathname:        pathname,
+		ComponentParentID:        parentID,
+		ComponentClass:           class,
+		ComponentSubstationClass: substationClass,
+	}
+	if err := n.Components.AddComponent(comp); err != nil {
+		t.Fatalf("adding test component %s: %v", alias, err)
+	}
+	return comp
+}
+
+func setTestRules(n *ComponentDb, rules ...*ComponentNameRule) {
+	for i, rule := range rules {
+		rule.NameRule = "TEST CB"
+		rule.TextIndex = i + 1
+		rule.UseSeparator = true
+	}
+	n.nameRules["TEST CB"] = rules
+}
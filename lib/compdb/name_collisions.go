@@ -0,0 +1,163 @@
+package compdb
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/3ideas/psasim/lib/csvutil"
+)
+
+// NameCollisionComponent is a component in a group of components that share a generated name
+type NameCollisionComponent struct {
+	Alias      string
+	Pathname   string
+	Substation string // alias of the substation the component is in
+	ClassName  string
+	RuleName   string
+	Location   string // the value of each name part, whether or not it was used in the name
+	Circuit    string
+	Plant      string
+	Origin     string
+}
+
+// NameCollision is a generated name shared by more than one alias
+type NameCollision struct {
+	Name       string
+	Components []*NameCollisionComponent
+	Suggestion string // the name part that would tell the components apart
+}
+
+// NameCollisionRow is the CSV row written for each component in a collision
+type NameCollisionRow struct {
+	Name       string `csv:"NAME"`
+	Alias      string `csv:"ALIAS"`
+	Pathname   string `csv:"PATHNAME"`
+	Substation string `csv:"SUBSTATION"`
+	ClassName  string `csv:"CLASS"`
+	RuleName   string `csv:"NAME_RULE"`
+	Location   string `csv:"LOCATION"`
+	Circuit    string `csv:"CIRCUIT"`
+	Plant      string `csv:"PLANT"`
+	Origin     string `csv:"ORIGIN"`
+	Suggestion string `csv:"SUGGESTION"`
+}
+
+// GetNameCollisions returns every generated name shared by more than one alias, sorted by name
+func (n *ComponentDb) GetNameCollisions() []*NameCollision {
+	collisions := []*NameCollision{}
+	for name, comps := range n.Components.componentsByName {
+		if len(comps) < 2 {
+			continue
+		}
+		collision := &NameCollision{Name: name}
+		for _, comp := range comps {
+			collision.Components = append(collision.Components, n.getNameCollisionComponent(comp))
+		}
+		sort.Slice(collision.Components, func(i, j int) bool {
+			return collision.Components[i].Alias < collision.Components[j].Alias
+		})
+		collision.Suggestion = suggestNamePart(collision.Components)
+		collisions = append(collisions, collision)
+	}
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].Name < collisions[j].Name
+	})
+	return collisions
+}
+
+func (n *ComponentDb) getNameCollisionComponent(comp *Component) *NameCollisionComponent {
+	entry := &NameCollisionComponent{Alias: comp.ComponentAlias, Pathname: comp.ComponentPathname}
+
+	for c := comp; c != nil; c = c.Parent {
+		if c.ComponentSubstationClass.IsSubstation() {
+			entry.Substation = c.ComponentAlias
+			break
+		}
+	}
+	if classDefn, err := n.GetComponentClassDefnByIndex(comp.ComponentClass); err == nil {
+		entry.ClassName = classDefn.ComponentClassName
+	}
+
+	name, err := n.getNameFull(comp.ComponentAlias, nil)
+	if err != nil {
+		slog.Warn("Name collisions: failed to get name", "alias", comp.ComponentAlias, "error", err)
+		return entry
+	}
+	entry.RuleName = name.RuleName
+	entry.Location = name.Location.Value
+	entry.Circuit = name.Circuit.Value
+	entry.Plant = name.Plant.Value
+	entry.Origin = name.Origin.Value
+	return entry
+}
+
+// suggestNamePart returns the first name part whose values differ for every component in the group.
+// Parts that can be added to the name (origin, plant) are checked before the parts already in it.
+func suggestNamePart(comps []*NameCollisionComponent) string {
+	parts := []struct {
+		name  string
+		value func(*NameCollisionComponent) string
+	}{
+		{"Origin", func(c *NameCollisionComponent) string { return c.Origin }},
+		{"Plant", func(c *NameCollisionComponent) string { return c.Plant }},
+		{"Circuit", func(c *NameCollisionComponent) string { return c.Circuit }},
+		{"Location", func(c *NameCollisionComponent) string { return c.Location }},
+		{"Pathname", func(c *NameCollisionComponent) string { return c.Pathname }},
+	}
+
+	for _, part := range parts {
+		values := make(map[string]bool)
+		for _, comp := range comps {
+			value := part.value(comp)
+			if value == "" || values[value] {
+				break
+			}
+			values[value] = true
+		}
+		if len(values) == len(comps) {
+			return part.name
+		}
+	}
+	return "None (name parts are identical)"
+}
+
+func (c *NameCollision) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Name: %q shared by %d components, distinguish by: %s\n", c.Name, len(c.Components), c.Suggestion)
+	for _, comp := range c.Components {
+		fmt.Fprintf(&sb, "    %-40s Sub: %-15s Class: %-30s Rule: %-20s Location: %-15s Circuit: %-20s Plant: %-20s Origin: %-20s\n",
+			comp.Alias, comp.Substation, comp.ClassName, comp.RuleName, comp.Location, comp.Circuit, comp.Plant, comp.Origin)
+	}
+	return sb.String()
+}
+
+// WriteNameCollisions writes the name collision report to a CSV file, one row per component
+func (n *ComponentDb) WriteNameCollisions(filename string) ([]*NameCollision, error) {
+	collisions := n.GetNameCollisions()
+
+	rows := []NameCollisionRow{}
+	for _, collision := range collisions {
+		for _, comp := range collision.Components {
+			rows = append(rows, NameCollisionRow{
+				Name:       collision.Name,
+				Alias:      comp.Alias,
+				Pathname:   comp.Pathname,
+				Substation: comp.Substation,
+				ClassName:  comp.ClassName,
+				RuleName:   comp.RuleName,
+				Location:   comp.Location,
+				Circuit:    comp.Circuit,
+				Plant:      comp.Plant,
+				Origin:     comp.Origin,
+				Suggestion: collision.Suggestion,
+			})
+		}
+	}
+
+	if err := csvutil.WriteCSV(filename, rows); err != nil {
+		return nil, fmt.Errorf("error writing name collisions to %s: %w", filename, err)
+	}
+	return collisions, nil
+}
@@ -0,0 +1,37 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameCollisions(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBA/132_CCT/W2", "W2", "1", 2, PrimaryCircuitID)
+	addTestComponent(t, n, "5", "SUBA/132_CCT/W2/CB", "CB2", "4", 3, PrimarySubstationComponent)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "4", AttributeName: "Circuit Name", AttributeValue: "NORTH"})
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "3", AttributeName: "Plant", AttributeValue: "BKR"})
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "5", AttributeName: "Plant", AttributeValue: "BKR"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+
+	collisions := n.GetNameCollisions()
+	assert.Len(t, collisions, 1)
+	collision := collisions[0]
+	assert.Equal(t, "SUBA, NORTH BKR", collision.Name)
+	assert.Len(t, collision.Components, 2)
+	assert.Equal(t, "SUBA/132_CCT/W1/CB", collision.Components[0].Alias)
+	assert.Equal(t, "SUBA", collision.Components[0].Substation)
+	assert.Equal(t, "Circuit Breaker", collision.Components[0].ClassName)
+	assert.Equal(t, "TEST CB", collision.Components[0].RuleName)
+	assert.Equal(t, "Pathname", collision.Suggestion)
+
+	// Once the circuits have different names the collision goes
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W2", "Circuit Name", "SOUTH"))
+	assert.Empty(t, n.GetNameCollisions())
+}
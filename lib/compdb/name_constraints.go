@@ -0,0 +1,275 @@
+package compdb
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/3ideas/psasim/lib/csvutil"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrConstraintViolation is matched (with errors.Is) by every *ConstraintError
+var ErrConstraintViolation = errors.New("name constraint violated")
+
+// ConstraintField is the value a constraint applies to
+type ConstraintField string
+
+const (
+	FieldAlias    ConstraintField = "Alias"
+	FieldPathname ConstraintField = "Pathname"
+	FieldName     ConstraintField = "Name" // the generated name
+)
+
+// ConstraintCheck identifies the constraint that was broken
+type ConstraintCheck string
+
+const (
+	CheckMaxLength         ConstraintCheck = "MaxLength"
+	CheckCharacters        ConstraintCheck = "Characters"
+	CheckReservedSeparator ConstraintCheck = "ReservedSeparator"
+)
+
+// ConstraintError is returned when an alias, pathname or generated name breaks the NameConstraints.
+// It is also used as a row of the bulk validation report.
+type ConstraintError struct {
+	Alias   string          `csv:"ALIAS"`
+	Field   ConstraintField `csv:"FIELD"`
+	Check   ConstraintCheck `csv:"CHECK"`
+	Value   string          `csv:"VALUE"`
+	Message string          `csv:"MESSAGE"`
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("%s %q of %s %s", strings.ToLower(string(e.Field)), e.Value, e.Alias, e.Message)
+}
+
+func (e *ConstraintError) Is(target error) bool {
+	return target == ErrConstraintViolation
+}
+
+// NameConstraints are the limits PowerOn puts on aliases, pathnames and generated names.
+// A zero length means no limit and an empty pattern allows any characters.
+type NameConstraints struct {
+	MaxAliasLength     int    `yaml:"max_alias_length"`
+	MaxPathnameLength  int    `yaml:"max_pathname_length"`
+	MaxNameLength      int    `yaml:"max_name_length"`
+	AliasCharacters    string `yaml:"alias_characters"`    // regexp the whole alias must match
+	PathnameCharacters string `yaml:"pathname_characters"` // regexp the whole pathname must match
+	NameCharacters     string `yaml:"name_characters"`     // regexp the whole generated name must match
+	ReservedSeparators string `yaml:"reserved_separators"` // characters not allowed in a pathname, ':' separates the parts of a path
+
+	aliasRegex    *regexp.Regexp
+	pathnameRegex *regexp.Regexp
+	nameRegex     *regexp.Regexp
+}
+
+// DefaultNameConstraints returns the built in constraints, they are used by ValidateNames unless SetNameConstraints
+// is called. Mutations are only checked once constraints are set.
+func DefaultNameConstraints() *NameConstraints {
+	c := &NameConstraints{
+		MaxAliasLength:     128, // the limit applied by comps.ReadComps
+		MaxPathnameLength:  128,
+		MaxNameLength:      128,
+		AliasCharacters:    `^[ -~]*$`, // printable ASCII
+		PathnameCharacters: `^[ -~]*$`,
+		NameCharacters:     `^[ -~]*$`,
+		ReservedSeparators: ":",
+	}
+	c.compile()
+	return c
+}
+
+// ReadNameConstraints reads a YAML constraints file, settings missing from the file are left unlimited
+func ReadNameConstraints(filename string) (*NameConstraints, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading name constraints %s: %w", filename, err)
+	}
+	var c NameConstraints
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("error parsing name constraints %s: %w", filename, err)
+	}
+	if err := c.compile(); err != nil {
+		return nil, fmt.Errorf("error in name constraints %s: %w", filename, err)
+	}
+	return &c, nil
+}
+
+func (c *NameConstraints) compile() error {
+	var err error
+	if c.aliasRegex, err = compileConstraintPattern(c.AliasCharacters); err != nil {
+		return fmt.Errorf("alias_characters: %w", err)
+	}
+	if c.pathnameRegex, err = compileConstraintPattern(c.PathnameCharacters); err != nil {
+		return fmt.Errorf("pathname_characters: %w", err)
+	}
+	if c.nameRegex, err = compileConstraintPattern(c.NameCharacters); err != nil {
+		return fmt.Errorf("name_characters: %w", err)
+	}
+	return nil
+}
+
+func compileConstraintPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile(pattern)
+}
+
+// ValidateAlias returns a *ConstraintError if the alias breaks the constraints
+func (c *NameConstraints) ValidateAlias(alias string) error {
+	return firstConstraintError(c.check(FieldAlias, alias, alias))
+}
+
+// ValidatePathname returns a *ConstraintError if the pathname of the component breaks the constraints
+func (c *NameConstraints) ValidatePathname(alias, pathname string) error {
+	return firstConstraintError(c.check(FieldPathname, alias, pathname))
+}
+
+// ValidateName returns a *ConstraintError if the generated name of the component breaks the constraints
+func (c *NameConstraints) ValidateName(alias, name string) error {
+	return firstConstraintError(c.check(FieldName, alias, name))
+}
+
+func firstConstraintError(violations []*ConstraintError) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return violations[0]
+}
+
+// check returns every constraint the value breaks
+func (c *NameConstraints) check(field ConstraintField, alias, value string) []*ConstraintError {
+	var maxLength int
+	var chars *regexp.Regexp
+	switch field {
+	case FieldAlias:
+		maxLength, chars = c.MaxAliasLength, c.aliasRegex
+	case FieldPathname:
+		maxLength, chars = c.MaxPathnameLength, c.pathnameRegex
+	case FieldName:
+		maxLength, chars = c.MaxNameLength, c.nameRegex
+	}
+
+	var violations []*ConstraintError
+	if maxLength > 0 && len(value) > maxLength {
+		violations = append(violations, &ConstraintError{Alias: alias, Field: field, Check: CheckMaxLength, Value: value,
+			Message: fmt.Sprintf("is %d characters, the maximum is %d", len(value), maxLength)})
+	}
+	if chars != nil && !chars.MatchString(value) {
+		violations = append(violations, &ConstraintError{Alias: alias, Field: field, Check: CheckCharacters, Value: value,
+			Message: fmt.Sprintf("has characters not allowed by %s", chars)})
+	}
+	if field == FieldPathname && c.ReservedSeparators != "" {
+		if i := strings.IndexAny(value, c.ReservedSeparators); i >= 0 {
+			violations = append(violations, &ConstraintError{Alias: alias, Field: field, Check: CheckReservedSeparator, Value: value,
+				Message: fmt.Sprintf("contains the reserved separator %q", value[i])})
+		}
+	}
+	return violations
+}
+
+// SetNameConstraints sets the constraints mutations are checked against, nil, the default, turns the checks off
+func (n *ComponentDb) SetNameConstraints(c *NameConstraints) error {
+	if c != nil {
+		if err := c.compile(); err != nil {
+			return fmt.Errorf("error in name constraints: %w", err)
+		}
+	}
+	n.constraints = c
+	return nil
+}
+
+// nameConstraints returns the constraints to check, nil if checks are off
+func (n *ComponentDb) nameConstraints() *NameConstraints {
+	return n.constraints
+}
+
+// startNameCheck starts recording the names a mutation changes, for checkGeneratedNames. It returns the depth
+// of the rollback stack to undo to if the mutation is rejected and a func to call when the mutation is done.
+// A mutation made by another mutation records into the same names.
+func (n *ComponentDb) startNameCheck() (int, func()) {
+	depth := len(n.rollbackStack)
+	if n.nameConstraints() == nil || n.oldNames != nil {
+		return depth, func() {}
+	}
+	n.oldNames = make(map[*Component]string)
+	n.checkRedo = n.redoStack
+	return depth, func() { n.oldNames, n.checkRedo = nil, nil }
+}
+
+// checkGeneratedNames checks the generated names changed since startNameCheck. If a name breaks a constraint
+// its name before the change did not, the changes made since depth are rolled back, the redo stack the change
+// cleared is restored and the error returned.
+// Names that already broke the constraints are left for ValidateNames to report.
+func (n *ComponentDb) checkGeneratedNames(comp *Component, depth int) error {
+	c := n.nameConstraints()
+	if c == nil {
+		return nil
+	}
+	changed := make([]*Component, 0, len(n.oldNames))
+	for changedComp := range n.oldNames {
+		changed = append(changed, changedComp)
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].ComponentAlias < changed[j].ComponentAlias })
+
+	for _, changedComp := range changed {
+		if changedComp.Name == "" || changedComp.Name == n.oldNames[changedComp] {
+			continue
+		}
+		if _, ok := n.componentsByID[changedComp.ComponentID]; !ok {
+			continue // removed again by a rejected change
+		}
+		existing := make(map[ConstraintCheck]bool)
+		if oldName := n.oldNames[changedComp]; oldName != "" {
+			for _, violation := range c.check(FieldName, changedComp.ComponentAlias, oldName) {
+				existing[violation.Check] = true
+			}
+		}
+		for _, violation := range c.check(FieldName, changedComp.ComponentAlias, changedComp.Name) {
+			if existing[violation.Check] {
+				continue
+			}
+			slog.Warn("Namer: change rejected, generated name breaks constraints", "alias", comp.ComponentAlias, "error", violation)
+			if rollbackErr := n.undoTo(depth, n.checkRedo); rollbackErr != nil {
+				return rollbackErr
+			}
+			return violation
+		}
+	}
+	return nil
+}
+
+// ValidateNames checks the alias, pathname and generated name of every component against the constraints,
+// or DefaultNameConstraints if none are set. Violations are sorted by alias, then alias, pathname and name.
+func (n *ComponentDb) ValidateNames() []*ConstraintError {
+	c := n.nameConstraints()
+	if c == nil {
+		c = DefaultNameConstraints()
+	}
+	violations := make([]*ConstraintError, 0)
+	for _, comp := range n.componentsByAlias {
+		violations = append(violations, c.check(FieldAlias, comp.ComponentAlias, comp.ComponentAlias)...)
+		violations = append(violations, c.check(FieldPathname, comp.ComponentAlias, comp.ComponentPathname)...)
+		if comp.Name != "" {
+			violations = append(violations, c.check(FieldName, comp.ComponentAlias, comp.Name)...)
+		}
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Alias < violations[j].Alias
+	})
+	return violations
+}
+
+// WriteConstraintViolations writes the violations found by ValidateNames to a CSV file
+func WriteConstraintViolations(filename string, violations []*ConstraintError) error {
+	if err := csvutil.WriteCSV(filename, violations); err != nil {
+		return fmt.Errorf("error writing constraint violations to %s: %w", filename, err)
+	}
+	return nil
+}
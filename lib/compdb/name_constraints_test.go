@@ -0,0 +1,120 @@
+package compdb
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameConstraints(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+
+	// Mutations are only checked once constraints are set, the default constraints reserve ':' for paths
+	assert.NoError(t, n.RenameComponent("SUBA/132_CCT/W1/CB", "CB:1"))
+	assert.NoError(t, n.Rollback())
+	assert.NoError(t, n.SetNameConstraints(DefaultNameConstraints()))
+	err := n.RenameComponent("SUBA/132_CCT/W1/CB", "CB:1")
+	assert.ErrorIs(t, err, ErrConstraintViolation)
+	var constraintErr *ConstraintError
+	assert.ErrorAs(t, err, &constraintErr)
+	assert.Equal(t, FieldPathname, constraintErr.Field)
+	assert.Equal(t, CheckReservedSeparator, constraintErr.Check)
+	assert.Equal(t, "CB1", cb.ComponentPathname)
+
+	err = n.CreateComponent(strings.Repeat("A", 129), "CB2", "SUBA/132_CCT/W1", "SUBA/132_CCT/W1/CB", "")
+	assert.ErrorAs(t, err, &constraintErr)
+	assert.Equal(t, FieldAlias, constraintErr.Field)
+	assert.Equal(t, CheckMaxLength, constraintErr.Check)
+
+	// A change that makes a generated name too long is rolled back, including the names that use it
+	assert.NoError(t, n.SetNameConstraints(&NameConstraints{MaxNameLength: 20}))
+	err = n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "A VERY LONG CIRCUIT NAME")
+	assert.ErrorAs(t, err, &constraintErr)
+	assert.Equal(t, FieldName, constraintErr.Field)
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+	attr, _ := n.GetAttributeValue("SUBA/132_CCT/W1", "Circuit Name")
+	assert.Equal(t, "NORTH", attr.Value)
+	changes, _ := n.GetNumberOfChanges()
+	assert.Equal(t, 0, changes)
+
+	// Moves are checked too
+	addTestComponent(t, n, "4", "SUBB", "A LONG SUBSTATION NAME", "0", 1, PrimarySubstation)
+	err = n.MoveComponent("SUBA/132_CCT/W1", "SUBB")
+	assert.ErrorAs(t, err, &constraintErr)
+	assert.Equal(t, "SUBA/132_CCT/W1", constraintErr.Alias)
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+
+	// A name that already broke the constraints does not stop a change
+	assert.NoError(t, n.SetNameConstraints(&NameConstraints{MaxNameLength: 10, NameCharacters: `^[A-Z0-9 ,]*$`}))
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "SOUTH"))
+	assert.Equal(t, "SUBA, SOUTH CB1", cb.Name)
+	// unless the change breaks another constraint
+	err = n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "south")
+	assert.ErrorAs(t, err, &constraintErr)
+	assert.Equal(t, CheckCharacters, constraintErr.Check)
+	assert.Equal(t, "SUBA, SOUTH CB1", cb.Name)
+	assert.NoError(t, n.Rollback())
+
+	// Turning the checks off accepts the change
+	assert.NoError(t, n.SetNameConstraints(nil))
+	assert.NoError(t, n.RenameComponent("SUBA/132_CCT/W1/CB", "CB:1"))
+
+	// ValidateNames uses the default constraints if none are set
+	violations := n.ValidateNames()
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "SUBA/132_CCT/W1/CB", violations[0].Alias)
+	assert.Equal(t, CheckReservedSeparator, violations[0].Check)
+
+	filename := filepath.Join(t.TempDir(), "violations.csv")
+	assert.NoError(t, WriteConstraintViolations(filename, violations))
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "ALIAS,FIELD,CHECK,VALUE,MESSAGE")
+}
+
+func TestNameConstraintsRejectedChangeKeepsRedo(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, n.SetNameConstraints(&NameConstraints{MaxNameLength: 20}))
+
+	assert.NoError(t, n.RenameComponent("SUBA/132_CCT/W1/CB", "CB2"))
+	assert.NoError(t, n.Rollback())
+
+	// A rejected change leaves the redo stack as it was
+	var constraintErr *ConstraintError
+	err := n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "A VERY LONG CIRCUIT NAME")
+	assert.ErrorAs(t, err, &constraintErr)
+	redos, _ := n.GetNumberOfRedos()
+	assert.Equal(t, 1, redos)
+
+	// The "Circuit name" attribute renames the component, the rename is rejected so the attribute is too
+	err = n.CreateAttribute("SUBA/132_CCT/W1/CB", "Circuit name", "A LONG PATHNAME")
+	assert.ErrorAs(t, err, &constraintErr)
+	_, err = n.GetAttributeValue("SUBA/132_CCT/W1/CB", "Circuit name")
+	assert.Error(t, err)
+	assert.Equal(t, "CB1", cb.ComponentPathname)
+	changes, _ := n.GetNumberOfChanges()
+	assert.Equal(t, 0, changes)
+	redos, _ = n.GetNumberOfRedos()
+	assert.Equal(t, 1, redos)
+
+	assert.NoError(t, n.Redo())
+	assert.Equal(t, "CB2", cb.ComponentPathname)
+	assert.Equal(t, "SUBA, NORTH CB2", cb.Name)
+}
@@ -0,0 +1,245 @@
+package compdb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/3ideas/psasim/lib/csvutil"
+)
+
+// NameDiffChange is the kind of difference found for an alias between two data loads
+type NameDiffChange string
+
+const (
+	NameDiffAdded   NameDiffChange = "Added"
+	NameDiffRemoved NameDiffChange = "Removed"
+	NameDiffChanged NameDiffChange = "Changed"
+)
+
+// NameDiffCause is what caused a generated name to change
+type NameDiffCause string
+
+const (
+	CauseAttribute NameDiffCause = "Attribute" // an attribute used by the name changed
+	CausePathname  NameDiffCause = "Pathname"  // the pathname of a component used by the name changed
+	CauseMove      NameDiffCause = "Move"      // the component or one of its parents moved in the hierarchy
+	CauseClass     NameDiffCause = "Class"     // the class of the component changed
+	CauseRule      NameDiffCause = "Rule"      // the name rule, or its rows, changed
+	CauseUnknown   NameDiffCause = "Unknown"
+)
+
+// NameDiff is a difference for an alias between two data loads
+type NameDiff struct {
+	Alias         string         `csv:"ALIAS"`
+	Change        NameDiffChange `csv:"CHANGE"`
+	OldName       string         `csv:"OLD_NAME"`
+	NewName       string         `csv:"NEW_NAME"`
+	OldParentPath string         `csv:"OLD_PARENT_PATH"`
+	NewParentPath string         `csv:"NEW_PARENT_PATH"`
+	OldClass      string         `csv:"OLD_CLASS"`
+	NewClass      string         `csv:"NEW_CLASS"`
+	OldRule       string         `csv:"OLD_NAME_RULE"`
+	NewRule       string         `csv:"NEW_NAME_RULE"`
+	Causes        string         `csv:"CAUSES"` // the causes of the change separated by ';'
+}
+
+// nameDiffInfo is what is compared for an alias in each data load
+type nameDiffInfo struct {
+	comp       *Component
+	name       *NameDetailsFull
+	parentPath string
+	parents    string // the aliases of the parents, used to detect moves
+	class      string
+}
+
+// DiffNames compares the generated names, parent paths, classes and name rules of every alias in two data loads.
+// Returns the differences sorted by alias.
+func DiffNames(oldDb, newDb *ComponentDb) []*NameDiff {
+	diffs := []*NameDiff{}
+
+	for alias, oldComp := range oldDb.componentsByAlias {
+		if _, ok := newDb.componentsByAlias[alias]; !ok {
+			before := oldDb.getNameDiffInfo(oldComp)
+			diffs = append(diffs, &NameDiff{Alias: alias, Change: NameDiffRemoved, OldName: before.nameValue(), OldParentPath: before.parentPath, OldClass: before.class, OldRule: before.ruleName()})
+		}
+	}
+
+	for alias, newComp := range newDb.componentsByAlias {
+		after := newDb.getNameDiffInfo(newComp)
+		oldComp, ok := oldDb.componentsByAlias[alias]
+		if !ok {
+			diffs = append(diffs, &NameDiff{Alias: alias, Change: NameDiffAdded, NewName: after.nameValue(), NewParentPath: after.parentPath, NewClass: after.class, NewRule: after.ruleName()})
+			continue
+		}
+		before := oldDb.getNameDiffInfo(oldComp)
+		if before.nameValue() == after.nameValue() && before.parentPath == after.parentPath && before.class == after.class && before.ruleName() == after.ruleName() {
+			continue
+		}
+
+		causes := []string{}
+		for _, cause := range getNameDiffCauses(oldDb, newDb, before, after) {
+			causes = append(causes, string(cause))
+		}
+		diffs = append(diffs, &NameDiff{
+			Alias:         alias,
+			Change:        NameDiffChanged,
+			OldName:       before.nameValue(),
+			NewName:       after.nameValue(),
+			OldParentPath: before.parentPath,
+			NewParentPath: after.parentPath,
+			OldClass:      before.class,
+			NewClass:      after.class,
+			OldRule:       before.ruleName(),
+			NewRule:       after.ruleName(),
+			Causes:        strings.Join(causes, ";"),
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Alias < diffs[j].Alias })
+	return diffs
+}
+
+func (n *ComponentDb) getNameDiffInfo(comp *Component) *nameDiffInfo {
+	info := &nameDiffInfo{comp: comp, class: comp.ComponentClass.String()}
+	if classDefn, err := n.GetComponentClassDefnByIndex(comp.ComponentClass); err == nil {
+		info.class = classDefn.ComponentClassName
+	}
+	if comp.Parent != nil {
+		info.parentPath = comp.Parent.GetFullPath()
+	}
+	parents := []string{}
+	for p := comp.Parent; p != nil; p = p.Parent {
+		parents = append(parents, p.ComponentAlias)
+	}
+	info.parents = strings.Join(parents, "|")
+	if name, err := n.getNameFull(comp.ComponentAlias, nil); err == nil {
+		info.name = name
+	}
+	return info
+}
+
+func (i *nameDiffInfo) nameValue() string {
+	if i.name == nil {
+		return ""
+	}
+	return i.name.Name
+}
+
+func (i *nameDiffInfo) ruleName() string {
+	if i.name == nil {
+		return ""
+	}
+	return i.name.RuleName
+}
+
+// getNameDiffCauses attributes the differences between the two loads of a component to their causes
+func getNameDiffCauses(oldDb, newDb *ComponentDb, before, after *nameDiffInfo) []NameDiffCause {
+	causes := []NameDiffCause{}
+	if before.class != after.class {
+		causes = append(causes, CauseClass)
+	}
+	if before.ruleName() != after.ruleName() || (before.name != nil && after.name != nil && !sameNameRules(before.name.Rule, after.name.Rule)) {
+		causes = append(causes, CauseRule)
+	}
+	if before.parents != after.parents {
+		causes = append(causes, CauseMove)
+	}
+
+	// The components the name was built from in either load, by alias
+	sources := map[string]bool{before.comp.ComponentAlias: true}
+	attrNames := map[string]bool{}
+	for _, info := range []*nameDiffInfo{before, after} {
+		if info.name == nil {
+			continue
+		}
+		for _, source := range info.name.Sources {
+			sources[source.ComponentAlias] = true
+		}
+		for _, rule := range info.name.Rule {
+			if usesAttribute(rule.TextType) {
+				attrNames[rule.Data] = true
+				attrNames[rule.Data2] = true
+			}
+		}
+	}
+	delete(attrNames, "")
+
+	pathnameChanged := false
+	attributeChanged := false
+	for alias := range sources {
+		oldSource, oldOk := oldDb.componentsByAlias[alias]
+		newSource, newOk := newDb.componentsByAlias[alias]
+		if !oldOk || !newOk {
+			continue
+		}
+		if oldSource.ComponentPathname != newSource.ComponentPathname {
+			pathnameChanged = true
+		}
+		for attrName := range attrNames {
+			oldAttr, oldErr := oldDb.GetComponentAttribute(oldSource.ComponentID, attrName)
+			newAttr, newErr := newDb.GetComponentAttribute(newSource.ComponentID, attrName)
+			if (oldErr == nil) != (newErr == nil) || (oldErr == nil && oldAttr.AttributeValue != newAttr.AttributeValue) {
+				attributeChanged = true
+			}
+		}
+	}
+	if attributeChanged {
+		causes = append(causes, CauseAttribute)
+	}
+	if pathnameChanged {
+		causes = append(causes, CausePathname)
+	}
+
+	if len(causes) == 0 {
+		causes = append(causes, CauseUnknown)
+	}
+	return causes
+}
+
+// sameNameRules returns true if both rule sets have the same rows
+func sameNameRules(a, b []*ComponentNameRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteNameDiffs writes the differences to a CSV file
+func WriteNameDiffs(filename string, diffs []*NameDiff) error {
+	if err := csvutil.WriteCSV(filename, diffs); err != nil {
+		return fmt.Errorf("error writing name differences to %s: %w", filename, err)
+	}
+	return nil
+}
+
+// NameDiffSummary returns a summary table of the differences, counted by change and by cause
+func NameDiffSummary(diffs []*NameDiff) string {
+	changes := make(map[NameDiffChange]int)
+	causes := make(map[NameDiffCause]int)
+	for _, diff := range diffs {
+		changes[diff.Change]++
+		if diff.Causes == "" {
+			continue
+		}
+		for _, cause := range strings.Split(diff.Causes, ";") {
+			causes[NameDiffCause(cause)]++
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-12s %8s\n", "Change", "Count")
+	for _, change := range []NameDiffChange{NameDiffAdded, NameDiffRemoved, NameDiffChanged} {
+		fmt.Fprintf(&sb, "%-12s %8d\n", change, changes[change])
+	}
+	fmt.Fprintf(&sb, "\n%-12s %8s\n", "Cause", "Count")
+	for _, cause := range []NameDiffCause{CauseAttribute, CausePathname, CauseMove, CauseClass, CauseRule, CauseUnknown} {
+		fmt.Fprintf(&sb, "%-12s %8d\n", cause, causes[cause])
+	}
+	return sb.String()
+}
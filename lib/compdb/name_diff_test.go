@@ -0,0 +1,43 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffNames(t *testing.T) {
+	rules := func() []*ComponentNameRule {
+		return []*ComponentNameRule{
+			{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+			{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+			{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+		}
+	}
+	oldDb := newTestNamer(t)
+	setTestRules(oldDb, rules()...)
+	addTestComponent(t, oldDb, "4", "SUBA/OLD", "OLD", "1", 3, PrimarySubstationComponent)
+
+	newDb := newTestNamer(t)
+	setTestRules(newDb, rules()...)
+	addTestComponent(t, newDb, "5", "SUBA/NEW", "NEW", "1", 3, PrimarySubstationComponent)
+	assert.NoError(t, newDb.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "SOUTH"))
+	assert.NoError(t, newDb.RenameComponent("SUBA/132_CCT/W1/CB", "CB9"))
+
+	diffs := DiffNames(oldDb, newDb)
+	assert.Len(t, diffs, 4)
+
+	assert.Equal(t, NameDiff{Alias: "SUBA/132_CCT/W1", Change: NameDiffChanged, OldName: "SUBA SUB, NORTH W1", NewName: "SUBA SUB, SOUTH W1",
+		OldParentPath: "ROOT:SUBA", NewParentPath: "ROOT:SUBA", OldClass: "Circuit", NewClass: "Circuit", OldRule: "<default>", NewRule: "<default>", Causes: "Attribute"}, *diffs[0])
+	assert.Equal(t, "SUBA/132_CCT/W1/CB", diffs[1].Alias)
+	assert.Equal(t, "SUBA, SOUTH CB9", diffs[1].NewName)
+	assert.Equal(t, "Attribute;Pathname", diffs[1].Causes)
+	assert.Equal(t, NameDiffAdded, diffs[2].Change)
+	assert.Equal(t, "SUBA/NEW", diffs[2].Alias)
+	assert.Equal(t, NameDiffRemoved, diffs[3].Change)
+	assert.Equal(t, "SUBA/OLD", diffs[3].Alias)
+
+	summary := NameDiffSummary(diffs)
+	assert.Contains(t, summary, "Changed             2")
+	assert.Contains(t, summary, "Attribute           2")
+}
@@ -0,0 +1,128 @@
+package compdb
+
+import "log/slog"
+
+// nameDependencies tracks the components each resolved name was built from, so after a change
+// only the names that used the changed component need to be re-resolved.
+// Name rules only select components from the parents of the component being named, so the
+// sources of a name are always the component itself or one of its parents.
+type nameDependencies struct {
+	sources map[string][]string            // component ID -> IDs of the components its name was built from
+	usedBy  map[string]map[string]struct{} // component ID -> IDs of the components whose name uses it
+}
+
+func newNameDependencies() *nameDependencies {
+	return &nameDependencies{
+		sources: make(map[string][]string),
+		usedBy:  make(map[string]map[string]struct{}),
+	}
+}
+
+func (d *nameDependencies) set(compID string, sourceIDs []string) {
+	d.remove(compID)
+	d.sources[compID] = sourceIDs
+	for _, sourceID := range sourceIDs {
+		if _, ok := d.usedBy[sourceID]; !ok {
+			d.usedBy[sourceID] = make(map[string]struct{})
+		}
+		d.usedBy[sourceID][compID] = struct{}{}
+	}
+}
+
+func (d *nameDependencies) remove(compID string) {
+	for _, sourceID := range d.sources[compID] {
+		delete(d.usedBy[sourceID], compID)
+		if len(d.usedBy[sourceID]) == 0 {
+			delete(d.usedBy, sourceID)
+		}
+	}
+	delete(d.sources, compID)
+}
+
+// dependents returns the IDs of the components whose name uses the component
+func (d *nameDependencies) dependents(compID string) []string {
+	ids := make([]string, 0, len(d.usedBy[compID]))
+	for id := range d.usedBy[compID] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// resolveComponentName generates the name for the component and records the components it was built from.
+// Returns an empty name if the name could not be generated.
+func (n *ComponentDb) resolveComponentName(comp *Component) string {
+	if n.nameDeps == nil {
+		n.nameDeps = newNameDependencies()
+	}
+	name, sourceIDs, ok := n.computeName(comp)
+	if !ok {
+		n.nameDeps.remove(comp.ComponentID)
+		return ""
+	}
+	n.nameDeps.set(comp.ComponentID, sourceIDs)
+	return name
+}
+
+// computeName generates the name for the component and returns the IDs of the components it was built from.
+// It only reads from the ComponentDb so can be called from multiple goroutines.
+func (n *ComponentDb) computeName(comp *Component) (string, []string, bool) {
+	name, err := n.getNameFull(comp.ComponentAlias, nil)
+	if err != nil {
+		return "", nil, false
+	}
+	sourceIDs := make([]string, 0, len(name.Sources))
+	for _, source := range name.Sources {
+		sourceIDs = append(sourceIDs, source.ComponentID)
+	}
+	return name.Name, sourceIDs, true
+}
+
+// refreshNames re-resolves the names of the components, keeping componentsByName up to date
+func (n *ComponentDb) refreshNames(comps []*Component) {
+	for _, comp := range comps {
+		oldName := comp.Name
+		if _, ok := n.oldNames[comp]; n.oldNames != nil && !ok {
+			n.oldNames[comp] = oldName // recorded for checkGeneratedNames
+		}
+		n.Components.removeFromNameIndex(comp)
+		comp.Name = n.resolveComponentName(comp)
+		n.Components.addToNameIndex(comp)
+		if comp.Name != oldName {
+			slog.Debug("Namer: name refreshed", "alias", comp.ComponentAlias, "oldName", oldName, "newName", comp.Name)
+		}
+	}
+}
+
+// refreshDependentNames re-resolves the name of the component and every component whose name uses it.
+// Used when the data of the component (pathname or attributes) changes.
+func (n *ComponentDb) refreshDependentNames(comp *Component) {
+	comps := []*Component{comp}
+	if n.nameDeps != nil {
+		for _, id := range n.nameDeps.dependents(comp.ComponentID) {
+			if dependent, ok := n.componentsByID[id]; ok && dependent != comp {
+				comps = append(comps, dependent)
+			}
+		}
+	}
+	n.refreshNames(comps)
+}
+
+// refreshSubtreeNames re-resolves the names of the component and all its children.
+// Used when the parents of the component change, as that can change which components the rules select.
+func (n *ComponentDb) refreshSubtreeNames(comp *Component) {
+	comps := []*Component{comp}
+	for _, alias := range comp.GetAllChildernAliases() {
+		if child, ok := n.componentsByAlias[alias]; ok {
+			comps = append(comps, child)
+		}
+	}
+	n.refreshNames(comps)
+}
+
+// forgetName removes a component that is being removed from the name indexes
+func (n *ComponentDb) forgetName(comp *Component) {
+	n.Components.removeFromNameIndex(comp)
+	if n.nameDeps != nil {
+		n.nameDeps.remove(comp.ComponentID)
+	}
+}
@@ -0,0 +1,76 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamesRefreshedAfterChanges(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+
+	// Renaming the substation refreshes the names and paths of everything under it
+	assert.NoError(t, n.RenameComponent("SUBA", "SUBX"))
+	assert.Equal(t, "SUBX, NORTH CB1", cb.Name)
+	assert.Len(t, n.componentsByName["SUBX, NORTH CB1"], 1)
+	assert.NotContains(t, n.componentsByName, "SUBA, NORTH CB1")
+	comp, ok := n.GetComponentByPath("ROOT:SUBX:W1:CB1")
+	assert.True(t, ok)
+	assert.Equal(t, cb, comp)
+	_, ok = n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.False(t, ok)
+
+	// Attribute changes refresh the components whose name uses the attribute
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "SOUTH"))
+	assert.Equal(t, "SUBX, SOUTH CB1", cb.Name)
+	assert.NoError(t, n.CreateAttribute("SUBA/132_CCT/W1/CB", "Plant", "BKR"))
+	assert.Equal(t, "SUBX, SOUTH BKR", cb.Name)
+
+	// Moving a component refreshes it and its children
+	addTestComponent(t, n, "4", "SUBB", "SUBB", "0", 1, PrimarySubstation)
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBB"))
+	assert.Equal(t, "SUBB, SOUTH BKR", cb.Name)
+	_, ok = n.GetComponentByPath("ROOT:SUBB:W1:CB1")
+	assert.True(t, ok)
+
+	// Rolling back restores the original names and paths
+	assert.NoError(t, n.RollbackAll())
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+	assert.Len(t, n.componentsByName["SUBA, NORTH CB1"], 1)
+	assert.NotContains(t, n.componentsByName, "SUBX, SOUTH BKR")
+	comp, ok = n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.True(t, ok)
+	assert.Equal(t, cb, comp)
+	assert.Len(t, n.ByPath, 4)
+}
+
+func TestNamesRefreshedAfterMissingAttributeCreated(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeValue, Data: "Circuit Label"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+
+	// The circuit rule selects the parent circuit, which has no label, so it gives no value
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Equal(t, "SUBA, CB1", cb.Name)
+
+	// Creating the missing attribute on the parent refreshes the child's name
+	assert.NoError(t, n.CreateAttribute("SUBA/132_CCT/W1", "Circuit Label", "EAST"))
+	assert.Equal(t, "SUBA, EAST CB1", cb.Name)
+	assert.Len(t, n.componentsByName["SUBA, EAST CB1"], 1)
+
+	assert.NoError(t, n.Rollback())
+	assert.Equal(t, "SUBA, CB1", cb.Name)
+}
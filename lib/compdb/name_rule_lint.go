@@ -0,0 +1,200 @@
+package compdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/3ideas/psasim/lib/csvutil"
+)
+
+// NameRuleCheck identifies the check that raised a NameRuleIssue
+type NameRuleCheck string
+
+const (
+	CheckMissingRule        NameRuleCheck = "MissingRule"        // a class refers to a name rule with no rows
+	CheckUnknownLocation    NameRuleCheck = "UnknownLocation"    // a row has an unknown TextLocation
+	CheckUnknownTextType    NameRuleCheck = "UnknownTextType"    // a row has an unknown TextType
+	CheckParentNotLocation  NameRuleCheck = "ParentNotLocation"  // a Parent (5) row is not followed by a Location row
+	CheckAttributeNotLoaded NameRuleCheck = "AttributeNotLoaded" // an attribute used by a row is not preloaded
+	CheckDuplicateIndex     NameRuleCheck = "DuplicateIndex"     // two rows in a rule have the same TextIndex
+	CheckIndexGap           NameRuleCheck = "IndexGap"           // the TextIndexes in a rule are not consecutive
+)
+
+// NameRuleIssue is a problem found in the name rule or class definition tables
+type NameRuleIssue struct {
+	Check     NameRuleCheck `csv:"CHECK" json:"check"`
+	NameRule  string        `csv:"NAME_RULE" json:"nameRule"`
+	ClassName string        `csv:"CLASS" json:"className,omitempty"`
+	TextIndex int           `csv:"TEXT_INDEX" json:"textIndex,omitempty"`
+	Message   string        `csv:"MESSAGE" json:"message"`
+}
+
+func (i *NameRuleIssue) String() string {
+	if i.ClassName != "" {
+		return fmt.Sprintf("%-18s Class: %s, Rule: %s - %s", i.Check, i.ClassName, i.NameRule, i.Message)
+	}
+	return fmt.Sprintf("%-18s Rule: %s Index: %d - %s", i.Check, i.NameRule, i.TextIndex, i.Message)
+}
+
+// LintNameRules checks the name rules and class definitions for problems that would stop names being generated correctly.
+// Issues are sorted by name rule, text index and check.
+func (n *ComponentDb) LintNameRules() []*NameRuleIssue {
+	issues := n.lintClassNameRules()
+
+	// With on demand lookups every attribute can be used, so only the preloaded set is checked without them
+	var loaded map[string]bool
+	if !n.attrLoader.onDemand() {
+		loaded = make(map[string]bool)
+		for _, name := range n.attributeNames {
+			loaded[name] = true
+		}
+	}
+	for ruleName, rules := range n.nameRules {
+		issues = append(issues, lintNameRuleRows(ruleName, rules, loaded)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].NameRule != issues[j].NameRule {
+			return issues[i].NameRule < issues[j].NameRule
+		}
+		if issues[i].TextIndex != issues[j].TextIndex {
+			return issues[i].TextIndex < issues[j].TextIndex
+		}
+		if issues[i].Check != issues[j].Check {
+			return issues[i].Check < issues[j].Check
+		}
+		return issues[i].ClassName < issues[j].ClassName
+	})
+	return issues
+}
+
+// lintClassNameRules checks every name rule referred to by a class has rows
+func (n *ComponentDb) lintClassNameRules() []*NameRuleIssue {
+	issues := []*NameRuleIssue{}
+	for _, classDefn := range n.classDefByIndex {
+		refs := []struct{ field, rule string }{
+			{"COMPONENT_NAME_RULE", classDefn.ComponentNameRule},
+			{"TRACED_NAME_RULE", classDefn.TracedNameRule},
+			{"TRACED_NAMING_COMP_NAME_RULE", classDefn.TracedNamingCompNameRule},
+		}
+		for _, ref := range refs {
+			if ref.rule == "" {
+				continue
+			}
+			if rules, ok := n.nameRules[ref.rule]; ok && len(rules) > 0 {
+				continue
+			}
+			issues = append(issues, &NameRuleIssue{
+				Check:     CheckMissingRule,
+				NameRule:  ref.rule,
+				ClassName: classDefn.ComponentClassName,
+				Message:   fmt.Sprintf("%s refers to a name rule with no rows", ref.field),
+			})
+		}
+	}
+	return issues
+}
+
+// notApplicableData is the Data of rows that do not name an attribute
+const notApplicableData = "Not Applicable"
+
+// lintNameRuleRows checks the rows of a single name rule, the attributes used are only checked if loadedAttributes is not nil
+func lintNameRuleRows(ruleName string, rules []*ComponentNameRule, loadedAttributes map[string]bool) []*NameRuleIssue {
+	issues := []*NameRuleIssue{}
+	add := func(check NameRuleCheck, index int, format string, args ...any) {
+		issues = append(issues, &NameRuleIssue{Check: check, NameRule: ruleName, TextIndex: index, Message: fmt.Sprintf(format, args...)})
+	}
+
+	sorted := make([]*ComponentNameRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].TextIndex < sorted[j].TextIndex })
+
+	for i, rule := range sorted {
+		if rule.TextLocation < NameRuleLocation || rule.TextLocation > NameRuleParent {
+			add(CheckUnknownLocation, rule.TextIndex, "unknown text location %d", rule.TextLocation)
+		}
+		if rule.TextType < NameRuleTextTypeAttributeElseName || rule.TextType > NameRuleTextTypeAttributeOriginIfDifferentElseName {
+			add(CheckUnknownTextType, rule.TextIndex, "unknown text type %d", rule.TextType)
+		}
+
+		if rule.TextLocation == NameRuleParent {
+			if i+1 >= len(sorted) {
+				add(CheckParentNotLocation, rule.TextIndex, "Parent row is the last row, expected a Location row to follow")
+			} else if sorted[i+1].TextLocation != NameRuleLocation {
+				add(CheckParentNotLocation, rule.TextIndex, "Parent row is followed by a %s row, expected Location", sorted[i+1].TextLocation)
+			}
+		}
+
+		if loadedAttributes != nil && usesAttribute(rule.TextType) && rule.TextLocation != NameRuleParent {
+			for _, attrName := range []string{rule.Data, rule.Data2} {
+				if attrName != "" && attrName != notApplicableData && !loadedAttributes[attrName] {
+					add(CheckAttributeNotLoaded, rule.TextIndex, "attribute %q is not in the preloaded attribute set", attrName)
+				}
+			}
+		}
+
+		if i == 0 {
+			continue
+		}
+		previous := sorted[i-1].TextIndex
+		switch {
+		case rule.TextIndex == previous:
+			add(CheckDuplicateIndex, rule.TextIndex, "text index %d is used more than once", rule.TextIndex)
+		case rule.TextIndex > previous+1:
+			add(CheckIndexGap, rule.TextIndex, "text index jumps from %d to %d", previous, rule.TextIndex)
+		}
+	}
+	return issues
+}
+
+// usesAttribute returns true if the text type looks up the attribute named in Data (and Data2)
+func usesAttribute(textType TextTypeType) bool {
+	switch textType {
+	case NameRuleTextTypeAttributeElseName, NameRuleTextTypeAttributeValue, NameRuleTextTypeAttributeOrigin, NameRuleTextTypeAttributeOriginIfDifferentElseName,
+		NameRuleTextTypeVoltage:
+		return true
+	}
+	return false
+}
+
+// WriteNameRuleIssues writes the issues to filename, as JSON if the file has a .json extension otherwise as CSV
+func WriteNameRuleIssues(filename string, issues []*NameRuleIssue) error {
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		data, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding name rule issues: %w", err)
+		}
+		if err := os.WriteFile(filename, data, 0644); err != nil {
+			return fmt.Errorf("error writing name rule issues to %s: %w", filename, err)
+		}
+		return nil
+	}
+
+	if err := csvutil.WriteCSV(filename, issues); err != nil {
+		return fmt.Errorf("error writing name rule issues to %s: %w", filename, err)
+	}
+	return nil
+}
+
+// NameRuleIssueSummary returns a human readable summary of the issues, a count for each check
+func NameRuleIssueSummary(issues []*NameRuleIssue) string {
+	counts := make(map[NameRuleCheck]int)
+	rules := make(map[string]bool)
+	for _, issue := range issues {
+		counts[issue.Check]++
+		rules[issue.NameRule] = true
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d name rule issues in %d rules\n", len(issues), len(rules))
+	for _, check := range []NameRuleCheck{CheckMissingRule, CheckUnknownLocation, CheckUnknownTextType, CheckParentNotLocation, CheckAttributeNotLoaded, CheckDuplicateIndex, CheckIndexGap} {
+		if counts[check] > 0 {
+			fmt.Fprintf(&sb, "    %-18s %d\n", check, counts[check])
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,43 @@
+package compdb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintNameRules(t *testing.T) {
+	n := newTestNamer(t)
+	addTestClass(n, 4, "Isolator", "ISO", "MISSING RULE")
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleParent, TextType: NameRuleTextTypeAttributeElseName},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeValue, Data: "Not Loaded", Data2: "Plant"},
+		&ComponentNameRule{TextLocation: 7, TextType: 12},
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+	)
+	rules := n.nameRules["TEST CB"]
+	rules[2].TextIndex = 2
+	rules[3].TextIndex = 5
+	rules[4].TextIndex = 6
+
+	issues := n.LintNameRules()
+	checks := []NameRuleCheck{}
+	for _, issue := range issues {
+		checks = append(checks, issue.Check)
+	}
+	assert.Equal(t, []NameRuleCheck{CheckMissingRule, CheckParentNotLocation, CheckAttributeNotLoaded, CheckDuplicateIndex, CheckIndexGap, CheckUnknownLocation, CheckUnknownTextType}, checks)
+	assert.Equal(t, "Isolator", issues[0].ClassName)
+	assert.Contains(t, issues[2].Message, "Not Loaded")
+	assert.Contains(t, NameRuleIssueSummary(issues), "7 name rule issues in 2 rules")
+
+	// Attributes not preloaded are looked up on demand, if that is enabled
+	db, err := sqlx.Open("sqlite", filepath.Join(t.TempDir(), "attrs.db"))
+	assert.NoError(t, err)
+	n.attrLoader = newAttributeLoader(db, n.attributeNames, DefaultAttributeCacheSize)
+	for _, issue := range n.LintNameRules() {
+		assert.NotEqual(t, CheckAttributeNotLoaded, issue.Check)
+	}
+}
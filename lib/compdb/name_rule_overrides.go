@@ -0,0 +1,274 @@
+package compdb
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/3ideas/psasim/lib/csvutil"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultNameRuleName is the name rule used for classes without a name rule, an override rule set
+// with this name replaces the built in DefaultNameRules
+const DefaultNameRuleName = "<default>"
+
+// ClassRuleOverride assigns a name rule to a class, the class is given by name or index
+type ClassRuleOverride struct {
+	Class    string `yaml:"class"`
+	NameRule string `yaml:"nameRule"`
+}
+
+// NameRuleOverrides are name rule sets and class to rule assignments applied on top of those read from the database
+type NameRuleOverrides struct {
+	Rules   []*ComponentNameRule // rule sets in the overrides replace any existing rule set with the same name
+	Classes []*ClassRuleOverride
+}
+
+// NameChange is a component whose name changed when overrides were applied
+type NameChange struct {
+	Alias    string `csv:"ALIAS"`
+	OldName  string `csv:"OLD_NAME"`
+	NewName  string `csv:"NEW_NAME"`
+	NameRule string `csv:"NAME_RULE"`
+}
+
+// nameRuleOverrideRow is a row of a CSV overrides file, the columns match COMPONENT_NAME_RULE.
+// A row with CLASS set assigns NAME_RULE to that class rather than adding a rule row.
+type nameRuleOverrideRow struct {
+	Class               string `csv:"CLASS"`
+	NameRule            string `csv:"NAME_RULE"`
+	TextIndex           string `csv:"TEXT_INDEX"`
+	TextLocation        string `csv:"TEXT_LOCATION"`
+	TextType            string `csv:"TEXT_TYPE"`
+	Data                string `csv:"DATA"`
+	PreText             string `csv:"PRE_TEXT"`
+	PostText            string `csv:"POST_TEXT"`
+	Comments            string `csv:"COMMENTS"`
+	Data2               string `csv:"DATA2"`
+	UseSeparator        string `csv:"USE_SEPARATOR"`
+	UseParentIfNotFound string `csv:"USE_PARENT_IF_NOT_FOUND"`
+	UseIfNotFound       string `csv:"USE_IF_NOT_FOUND"`
+	UseOriginIfNotFound string `csv:"USE_ORIGIN_IF_NOT_FOUND"`
+}
+
+// nameRuleOverrideYAML is a rule row in a YAML overrides file
+type nameRuleOverrideYAML struct {
+	NameRule            string `yaml:"nameRule"`
+	TextIndex           int    `yaml:"textIndex"`
+	TextLocation        int    `yaml:"textLocation"`
+	TextType            int    `yaml:"textType"`
+	Data                string `yaml:"data"`
+	PreText             string `yaml:"preText"`
+	PostText            string `yaml:"postText"`
+	Comments            string `yaml:"comments"`
+	Data2               string `yaml:"data2"`
+	UseSeparator        *bool  `yaml:"useSeparator"` // defaults to true, as it does in the database
+	UseParentIfNotFound bool   `yaml:"useParentIfNotFound"`
+	UseIfNotFound       bool   `yaml:"useIfNotFound"`
+	UseOriginIfNotFound bool   `yaml:"useOriginIfNotFound"`
+}
+
+type nameRuleOverridesYAML struct {
+	Rules   []*nameRuleOverrideYAML `yaml:"rules"`
+	Classes []*ClassRuleOverride    `yaml:"classes"`
+}
+
+// ReadNameRuleOverrides reads an overrides file, YAML if the extension is .yaml or .yml otherwise CSV
+func ReadNameRuleOverrides(filename string) (*NameRuleOverrides, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		return readNameRuleOverridesYAML(filename)
+	default:
+		return readNameRuleOverridesCSV(filename)
+	}
+}
+
+func readNameRuleOverridesYAML(filename string) (*NameRuleOverrides, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading name rule overrides %s: %w", filename, err)
+	}
+	var file nameRuleOverridesYAML
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error parsing name rule overrides %s: %w", filename, err)
+	}
+
+	overrides := &NameRuleOverrides{Classes: file.Classes}
+	for _, row := range file.Rules {
+		useSeparator := true
+		if row.UseSeparator != nil {
+			useSeparator = *row.UseSeparator
+		}
+		overrides.Rules = append(overrides.Rules, &ComponentNameRule{
+			NameRule:            row.NameRule,
+			TextIndex:           row.TextIndex,
+			TextLocation:        TextLocationType(row.TextLocation),
+			TextType:            TextTypeType(row.TextType),
+			Data:                row.Data,
+			PreText:             row.PreText,
+			PostText:            row.PostText,
+			Comments:            row.Comments,
+			Data2:               row.Data2,
+			UseSeparator:        useSeparator,
+			UseParentIfNotFound: row.UseParentIfNotFound,
+			UseIfNotFound:       row.UseIfNotFound,
+			UseOriginIfNotFound: row.UseOriginIfNotFound,
+		})
+	}
+	return overrides, nil
+}
+
+func readNameRuleOverridesCSV(filename string) (*NameRuleOverrides, error) {
+	rows, err := csvutil.ReadItems[nameRuleOverrideRow](filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading name rule overrides %s: %w", filename, err)
+	}
+
+	overrides := &NameRuleOverrides{}
+	for i, row := range rows {
+		if row.Class != "" {
+			overrides.Classes = append(overrides.Classes, &ClassRuleOverride{Class: row.Class, NameRule: row.NameRule})
+			continue
+		}
+		rule, err := row.toNameRule()
+		if err != nil {
+			return nil, fmt.Errorf("error in name rule overrides %s row %d: %w", filename, i+2, err)
+		}
+		overrides.Rules = append(overrides.Rules, rule)
+	}
+	return overrides, nil
+}
+
+func (r *nameRuleOverrideRow) toNameRule() (*ComponentNameRule, error) {
+	var err error
+	rule := &ComponentNameRule{
+		NameRule: r.NameRule,
+		Data:     r.Data,
+		PreText:  r.PreText,
+		PostText: r.PostText,
+		Comments: r.Comments,
+		Data2:    r.Data2,
+	}
+	ints := []struct {
+		column string
+		value  string
+		target *int
+	}{
+		{"TEXT_INDEX", r.TextIndex, &rule.TextIndex},
+		{"TEXT_LOCATION", r.TextLocation, (*int)(&rule.TextLocation)},
+		{"TEXT_TYPE", r.TextType, (*int)(&rule.TextType)},
+	}
+	for _, i := range ints {
+		if *i.target, err = strconv.Atoi(strings.TrimSpace(i.value)); err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': %w", i.column, i.value, err)
+		}
+	}
+	bools := []struct {
+		column   string
+		value    string
+		target   *bool
+		fallback bool
+	}{
+		{"USE_SEPARATOR", r.UseSeparator, &rule.UseSeparator, true},
+		{"USE_PARENT_IF_NOT_FOUND", r.UseParentIfNotFound, &rule.UseParentIfNotFound, false},
+		{"USE_IF_NOT_FOUND", r.UseIfNotFound, &rule.UseIfNotFound, false},
+		{"USE_ORIGIN_IF_NOT_FOUND", r.UseOriginIfNotFound, &rule.UseOriginIfNotFound, false},
+	}
+	for _, b := range bools {
+		if strings.TrimSpace(b.value) == "" {
+			*b.target = b.fallback
+			continue
+		}
+		if *b.target, err = strconv.ParseBool(strings.TrimSpace(b.value)); err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': %w", b.column, b.value, err)
+		}
+	}
+	return rule, nil
+}
+
+// ApplyNameRuleOverrides replaces or adds the rule sets in the overrides, assigns the class rules
+// then re-resolves all names. Returns the components whose name changed, sorted by alias.
+func (n *ComponentDb) ApplyNameRuleOverrides(overrides *NameRuleOverrides) ([]*NameChange, error) {
+	ruleSets := make(map[string][]*ComponentNameRule)
+	for _, rule := range overrides.Rules {
+		if rule.NameRule == "" {
+			return nil, fmt.Errorf("name rule override with text index %d has no name rule", rule.TextIndex)
+		}
+		ruleSets[rule.NameRule] = append(ruleSets[rule.NameRule], rule)
+	}
+
+	classes := make([]*ComponentClassDefn, 0, len(overrides.Classes))
+	for _, classOverride := range overrides.Classes {
+		classDefn, err := n.findClassDefn(classOverride.Class)
+		if err != nil {
+			return nil, fmt.Errorf("error applying name rule override for class %s: %w", classOverride.Class, err)
+		}
+		if _, ok := ruleSets[classOverride.NameRule]; !ok && classOverride.NameRule != "" {
+			if _, ok := n.nameRules[classOverride.NameRule]; !ok {
+				return nil, fmt.Errorf("name rule override for class %s refers to unknown name rule '%s'", classOverride.Class, classOverride.NameRule)
+			}
+		}
+		classes = append(classes, classDefn)
+	}
+
+	for ruleName, rules := range ruleSets {
+		sort.SliceStable(rules, func(i, j int) bool { return rules[i].TextIndex < rules[j].TextIndex })
+		_, replaced := n.nameRules[ruleName]
+		slog.Info("Namer: name rule override", "rule", ruleName, "rows", len(rules), "replaced", replaced)
+		n.nameRules[ruleName] = rules
+	}
+	for i, classOverride := range overrides.Classes {
+		slog.Info("Namer: class name rule override", "class", classes[i].ComponentClassName, "oldRule", classes[i].ComponentNameRule, "newRule", classOverride.NameRule)
+		classes[i].ComponentNameRule = classOverride.NameRule
+	}
+
+	oldNames := make(map[*Component]string, len(n.componentsByAlias))
+	for _, comp := range n.componentsByAlias {
+		oldNames[comp] = comp.Name
+	}
+	n.ResolveNames()
+
+	changes := []*NameChange{}
+	for comp, oldName := range oldNames {
+		if comp.Name == oldName {
+			continue
+		}
+		ruleName, _, _ := n.GetNameRulesForComponent(comp.ComponentAlias)
+		changes = append(changes, &NameChange{Alias: comp.ComponentAlias, OldName: oldName, NewName: comp.Name, NameRule: ruleName})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Alias < changes[j].Alias })
+	return changes, nil
+}
+
+// findClassDefn finds a class definition by name or index
+func (n *ComponentDb) findClassDefn(class string) (*ComponentClassDefn, error) {
+	if classDefn, err := n.GetComponentClassDefn(class); err == nil {
+		return classDefn, nil
+	}
+	index, err := strconv.Atoi(strings.TrimSpace(class))
+	if err != nil {
+		return nil, fmt.Errorf("no component class found for '%s'", class)
+	}
+	return n.GetComponentClassDefnByIndex(ComponentClassIndex(index))
+}
+
+// defaultNameRules returns the <default> rule set from the overrides if one was loaded, otherwise the built in DefaultNameRules
+func (n *ComponentDb) defaultNameRules() []*ComponentNameRule {
+	if rules, ok := n.nameRules[DefaultNameRuleName]; ok && len(rules) > 0 {
+		return rules
+	}
+	return DefaultNameRules()
+}
+
+// WriteNameChanges writes the name changes to a CSV file
+func WriteNameChanges(filename string, changes []*NameChange) error {
+	if err := csvutil.WriteCSV(filename, changes); err != nil {
+		return fmt.Errorf("error writing name changes to %s: %w", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package compdb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameRuleOverrides(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n, &ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"})
+	n.ResolveNames()
+
+	yamlFile := filepath.Join(t.TempDir(), "overrides.yaml")
+	assert.NoError(t, os.WriteFile(yamlFile, []byte(`
+rules:
+  - {nameRule: "<default>", textIndex: 1, textLocation: 1, textType: 4, data: DEF}
+  - {nameRule: NEW CB, textIndex: 2, textLocation: 3, textType: 4, data: BREAKER}
+  - {nameRule: NEW CB, textIndex: 1, textLocation: 1, textType: 1, data: Not Applicable}
+classes:
+  - {class: Circuit Breaker, nameRule: NEW CB}
+`), 0644))
+	overrides, err := ReadNameRuleOverrides(yamlFile)
+	assert.NoError(t, err)
+	assert.True(t, overrides.Rules[0].UseSeparator)
+
+	changes, err := n.ApplyNameRuleOverrides(overrides)
+	assert.NoError(t, err)
+	assert.Equal(t, []*NameChange{
+		{Alias: "SUBA", OldName: "SUBA SUB, SUBA SUB", NewName: "DEF", NameRule: DefaultNameRuleName},
+		{Alias: "SUBA/132_CCT/W1", OldName: "SUBA SUB, NORTH W1", NewName: "DEF", NameRule: DefaultNameRuleName},
+		{Alias: "SUBA/132_CCT/W1/CB", OldName: "SUBA", NewName: "SUBA, BREAKER", NameRule: "NEW CB"},
+	}, changes)
+
+	csvFile := filepath.Join(t.TempDir(), "overrides.csv")
+	assert.NoError(t, os.WriteFile(csvFile, []byte(`CLASS,NAME_RULE,TEXT_INDEX,TEXT_LOCATION,TEXT_TYPE,DATA,USE_SEPARATOR
+,CSV CB,1,1,1,Not Applicable,
+,CSV CB,2,3,4,CB,0
+3,CSV CB,,,,,
+`), 0644))
+	overrides, err = ReadNameRuleOverrides(csvFile)
+	assert.NoError(t, err)
+	assert.Len(t, overrides.Rules, 2)
+	assert.False(t, overrides.Rules[1].UseSeparator)
+
+	changes, err = n.ApplyNameRuleOverrides(overrides)
+	assert.NoError(t, err)
+	assert.Equal(t, []*NameChange{{Alias: "SUBA/132_CCT/W1/CB", OldName: "SUBA, BREAKER", NewName: "SUBA, CB", NameRule: "CSV CB"}}, changes)
+
+	_, err = n.ApplyNameRuleOverrides(&NameRuleOverrides{Classes: []*ClassRuleOverride{{Class: "Circuit Breaker", NameRule: "UNKNOWN"}}})
+	assert.Error(t, err)
+}
@@ -28,8 +28,9 @@ type NameWithHierachy struct {
 }
 
 func (n *ComponentDb) GetNameWithHierarchy(alias string) (*NameWithHierachy, error) {
-
-	nameFull, err := n.GetNameFull(alias)
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	nameFull, err := n.getNameFull(alias, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error getting name for component %s, %w", alias, err)
 	}
@@ -37,7 +38,7 @@ func (n *ComponentDb) GetNameWithHierarchy(alias string) (*NameWithHierachy, err
 	ParentsWithInfo := make([]*ComponentInfo, len(nameFull.Parents))
 
 	for i, comp := range nameFull.Parents {
-		compInfo, err := n.GetComponentInfoByID(comp.ComponentID)
+		compInfo, err := n.getComponentInfoByID(comp.ComponentID)
 		if err != nil {
 			return nil, fmt.Errorf("error getting component by ID %s, %w", comp.ComponentID, err)
 		}
@@ -49,7 +50,8 @@ func (n *ComponentDb) GetNameWithHierarchy(alias string) (*NameWithHierachy, err
 }
 
 func (n *ComponentDb) GetHierarchyByAlias(alias string) (Hierarchy, error) {
-
+	n.mu.RLock()
+	defer n.mu.RUnlock()
 	// comp, err := n.GetComponent(alias)
 	// if err != nil {
 	// 	return nil, fmt.Errorf("error getting component by ID %s, %w", id, err)
@@ -63,7 +65,7 @@ func (n *ComponentDb) GetHierarchyByAlias(alias string) (Hierarchy, error) {
 	compInfos := make(Hierarchy, len(parents))
 
 	for i, parent := range parents {
-		compInfo, err := n.GetComponentInfoByID(parent.ComponentID)
+		compInfo, err := n.getComponentInfoByID(parent.ComponentID)
 		if err != nil {
 			return nil, fmt.Errorf("error getting component info by ID %s, %w", parent.ComponentID, err)
 		}
@@ -73,12 +75,21 @@ func (n *ComponentDb) GetHierarchyByAlias(alias string) (Hierarchy, error) {
 }
 
 func (n *ComponentDb) GetComponentInfoByID(id string) (*ComponentInfo, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.getComponentInfoByID(id)
+}
+
+func (n *ComponentDb) getComponentInfoByID(id string) (*ComponentInfo, error) {
 
 	comp, err := n.GetComponentByID(id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting component by ID %s, %w", id, err)
 	}
+	return n.componentInfo(comp), nil
+}
 
+func (n *ComponentDb) componentInfo(comp *Component) *ComponentInfo {
 	clone, err := n.GetComponentByID(comp.ComponentCloneID)
 
 	clonePathname := ""
@@ -87,8 +98,11 @@ func (n *ComponentDb) GetComponentInfoByID(id string) (*ComponentInfo, error) {
 		clonePathname = clone.ComponentPathname
 	}
 
-	classInfo := n.classDefByIndex[comp.ComponentClass]
-	componentClassName := classInfo.ComponentClassName
+	// Components created without a template have no class
+	componentClassName, nameRule := "", ""
+	if classInfo, ok := n.classDefByIndex[comp.ComponentClass]; ok {
+		componentClassName, nameRule = classInfo.ComponentClassName, classInfo.ComponentNameRule
+	}
 
 	inSymbol := n.IsInSymbol(comp)
 
@@ -100,46 +114,21 @@ func (n *ComponentDb) GetComponentInfoByID(id string) (*ComponentInfo, error) {
 		ID:                  comp.ComponentID,
 		CloneID:             comp.ComponentCloneID,
 		ClonePathname:       clonePathname,
-		NameRule:            classInfo.ComponentNameRule,
+		NameRule:            nameRule,
 		InSymbol:            inSymbol,
 	}
 
-	return &compInfo, nil
+	return &compInfo
 }
 
 func (n *ComponentDb) GetComponentInfo(alias string) (*ComponentInfo, error) {
-
+	n.mu.RLock()
+	defer n.mu.RUnlock()
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return nil, fmt.Errorf("error getting component by alias %s, %w", alias, err)
 	}
-
-	clone, err := n.GetComponentByID(comp.ComponentCloneID)
-
-	clonePathname := ""
-
-	if err == nil && clone != nil {
-		clonePathname = clone.ComponentPathname
-	}
-
-	classInfo := n.classDefByIndex[comp.ComponentClass]
-	componentClassName := classInfo.ComponentClassName
-
-	inSymbol := n.IsInSymbol(comp)
-
-	compInfo := ComponentInfo{
-		Alias:               comp.ComponentAlias,
-		Path:                comp.ComponentPathname,
-		ComponentClassName:  componentClassName,
-		SubstationClassName: comp.ComponentSubstationClass.String(),
-		ID:                  comp.ComponentID,
-		CloneID:             comp.ComponentCloneID,
-		ClonePathname:       clonePathname,
-		NameRule:            classInfo.ComponentNameRule,
-		InSymbol:            inSymbol,
-	}
-
-	return &compInfo, nil
+	return n.componentInfo(comp), nil
 }
 
 // IsInSymbol Determin if a component is in a multi comp symbol
@@ -215,7 +204,8 @@ func (h Hierarchy) GetSymbolComponent() *ComponentInfo {
 }
 
 func (n *ComponentDb) GetChildrenInfoByID(id string) ([]*ComponentInfo, error) {
-
+	n.mu.RLock()
+	defer n.mu.RUnlock()
 	comp, err := n.GetComponentByID(id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting component by ID %s, %w", id, err)
@@ -227,7 +217,7 @@ func (n *ComponentDb) GetChildrenInfoByID(id string) ([]*ComponentInfo, error) {
 
 	childrenInfo := make([]*ComponentInfo, len(children))
 	for i, child := range children {
-		childInfo, err := n.GetComponentInfoByID(child.ComponentID)
+		childInfo, err := n.getComponentInfoByID(child.ComponentID)
 		if err != nil {
 			return nil, err
 		}
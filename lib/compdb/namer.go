@@ -3,20 +3,57 @@ package compdb
 import (
 	"fmt"
 	"os"
+	"sync"
 
 	_ "github.com/glebarez/go-sqlite"
 	"github.com/jmoiron/sqlx"
 )
 
+// DefaultAttributeNames are the attributes preloaded from the database, attributes used by name rules must be in this list
+var DefaultAttributeNames = []string{"State Alarm Text", "Not Valid", "Location Name", "Location ID", "Device Name", "Circuit Name", "Switch Number", "Plant", "Supplementary Text", "State Alarm", "State Index", "Alarm Treatment",
+	"State 0 Text", "State 1 Text", "State 2 Text", "State 3 Text", "State 4 Text", "State 5 Text", "State 6 Text", "State 7 Text",
+	"State 0 text", "State 1 text", "State 2 text", "State 3 text", "State 4 text", "State 5 text", "State 6 text", "State 7 text",
+}
+
+// ComponentDb is safe for concurrent use through the name service methods (namerif.NameService), reads
+// share mu while changes, rollbacks and previews hold it exclusively. Loading, patching and the settings
+// (Set*, Apply*, ResolveNames) are not locked, they are done before the ComponentDb is shared.
+// Clients that should not see each other's changes make their calls in a session, see WithSession.
 type ComponentDb struct {
+	mu sync.RWMutex
+
 	db *sqlx.DB
 	*ComponentClassDefns
 	*ComponentNameRules
 	*Components
 	*Attributes
 
-	rollbackPoint int
 	rollbackStack []RollbackOperation
+	savepoints    []Savepoint // oldest first
+	redoStack     []Change    // changes undone by Rollback, the last undone at the end
+	redoing       bool        // set while Redo re-applies a change, so the redo stack is kept
+
+	sessionMu         sync.RWMutex // held shared by calls in the active session, exclusively to switch sessions
+	sessions          map[string]*Session
+	activeSession     *Session    // nil while the shared changes are active
+	sessionBase       int         // the rollback stack below this holds the shared changes, above it the session's
+	sharedSavepoints  []Savepoint // the shared savepoints and redo stack while a session is active
+	sharedRedoStack   []Change
+	maxSessionChanges int // changes a session can have, 0 for no limit
+
+	tracedNaming   bool
+	nameDeps       *nameDependencies
+	attributeNames []string              // the attribute names preloaded from the database
+	attrLoader     *attributeLoader      // looks up attributes that were not preloaded
+	constraints    *NameConstraints      // checked by the mutations, nil if the checks are off
+	oldNames       map[*Component]string // names before the mutation being checked, see startNameCheck
+	checkRedo      []Change              // the redo stack before the mutation being checked, restored if it is rejected
+
+	patchChanges    []*PatchChange   // the changes made by the patches applied when loading
+	placementPolicy *PlacementPolicy // checked by MoveComponent, nil allows any move that does not create a cycle
+
+	source      string      // the database file loaded, empty if the ComponentDb was built in memory
+	loadOptions LoadOptions // the options it was loaded with, recorded in snapshots
 }
 
 func NewCompDb() *ComponentDb {
@@ -26,10 +63,16 @@ func NewCompDb() *ComponentDb {
 		ComponentNameRules:  NewComponentNameRules(),
 		Components:          NewComponentManager(),
 		Attributes:          NewAttributeManager(),
+		nameDeps:            newNameDependencies(),
+		attributeNames:      DefaultAttributeNames,
 	}
 }
 
 func LoadCompDb(dbFile string) (*ComponentDb, error) {
+	return LoadCompDbWithOptions(dbFile, DefaultLoadOptions())
+}
+
+func LoadCompDbWithOptions(dbFile string, opts LoadOptions) (*ComponentDb, error) {
 
 	var namer ComponentDb
 
@@ -38,6 +81,7 @@ func LoadCompDb(dbFile string) (*ComponentDb, error) {
 		return nil, err
 	}
 	namer.db = db
+	namer.source = dbFile
 
 	namer.ComponentClassDefns, err = GetComponentClasses(db)
 	if err != nil {
@@ -57,19 +101,31 @@ func LoadCompDb(dbFile string) (*ComponentDb, error) {
 
 	namer.Components.BuildHierarchy()
 
-	attributeNames := []string{"State Alarm Text", "Not Valid", "Location Name", "Location ID", "Device Name", "Circuit Name", "Switch Number", "Plant", "Supplementary Text", "State Alarm", "State Index", "Alarm Treatment",
-		"State 0 Text", "State 1 Text", "State 2 Text", "State 3 Text", "State 4 Text", "State 5 Text", "State 6 Text", "State 7 Text",
-		"State 0 text", "State 1 text", "State 2 text", "State 3 text", "State 4 text", "State 5 text", "State 6 text", "State 7 text",
+	namer.attributeNames = opts.AttributeNames
+	if len(namer.attributeNames) == 0 {
+		namer.attributeNames = DefaultAttributeNames
 	}
-	namer.Attributes, err = GetAttributes(db, attributeNames)
+	namer.loadOptions = opts
+	namer.loadOptions.AttributeNames = namer.attributeNames
+	namer.Attributes, err = GetAttributes(db, namer.attributeNames)
 	if err != nil {
 		return nil, err
 	}
+	namer.attrLoader = newAttributeLoader(db, namer.attributeNames, opts.AttributeCacheSize)
 
 	fmt.Println("Resolving names")
 	namer.ResolveNames()
 	fmt.Println("Names resolved")
 
+	if opts.Patch != 0 {
+		patches, err := GetComponentPatches(db, opts.Patch)
+		if err != nil {
+			return nil, err
+		}
+		namer.ApplyComponentPatches(patches)
+		fmt.Printf("Applied %d patches\n", len(patches))
+	}
+
 	return &namer, nil
 }
 
@@ -120,7 +176,7 @@ func (n *ComponentDb) GetNameRulesForComponent(alias string) (string, []*Compone
 	}
 
 	if classDefn.ComponentNameRule == "" {
-		return "<default>", DefaultNameRules(), nil
+		return DefaultNameRuleName, n.defaultNameRules(), nil
 	}
 
 	rules, ok := n.GetComponentNameRule(classDefn.ComponentNameRule)
@@ -134,7 +190,8 @@ func (n *ComponentDb) GetNameRulesForComponent(alias string) (string, []*Compone
 
 // GetAttributeValue returns the value of the attribute as a AttributeValue struct this is for the NameService interface
 func (n *ComponentDb) GetAttributeValue(alias string, attributeName string) (AttributeValue, error) {
-
+	n.mu.RLock()
+	defer n.mu.RUnlock()
 	comp, err := n.GetComponent(alias)
 	if err != nil {
 		return AttributeValue{}, err
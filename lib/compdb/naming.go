@@ -15,6 +15,9 @@ const (
 	NLClassAbbreviation NameLocationType = 4
 	NLAttrubuteOrigin   NameLocationType = 5
 	NLNameOrigin        NameLocationType = 6
+	NLData              NameLocationType = 7
+	NLVoltage           NameLocationType = 8
+	NLUserReference     NameLocationType = 9
 )
 
 type NamePartDetails struct {
@@ -24,11 +27,17 @@ type NamePartDetails struct {
 	AttributeName string
 	RawValue      string
 	Separator     string
+	PreText       string
+	PostText      string
+	JoinText      string // text placed between this and the previous part, empty if the rule does not use a separator
+	Data2Used     bool   // the value came from the rule's Data2 rather than Data, for Data rules the value includes Data2
+	TextError     string // why an alarm text template could not be fully rendered, e.g. unsupported directives
 }
 
 type NamePart struct {
-	Name    string
-	Details []*NamePartDetails
+	Name      string
+	Details   []*NamePartDetails
+	Consulted []*Component // the components the rules selected, whether or not they gave a value
 }
 
 func (n NamePart) String() string {
@@ -67,7 +76,7 @@ type NamePartResponse struct {
 func (n NamePartResponse) String() string {
 	multipleDetails := ""
 	for _, detail := range n.Details {
-		multipleDetails += fmt.Sprintf("\n             Val: %-15s: Src: %-20s, Raw: %-15s  Sep: %3s", detail.Value, detail.Source, detail.RawValue, detail.Separator)
+		multipleDetails += fmt.Sprintf("\n             Val: %-15s: Src: %-20s, Raw: %-15s  Pre: %3s  Sep: %3s  Join: %q  Data2: %t", detail.Value, detail.Source, detail.RawValue, detail.PreText, detail.Separator, detail.JoinText, detail.Data2Used)
 	}
 	return fmt.Sprintf("Name: %-15s Comp: %-35s Used: %t%s", n.Value, n.Alias, n.Used, multipleDetails)
 }
@@ -77,12 +86,19 @@ type NamePartDetailsResponse struct {
 	Source    string
 	RawValue  string
 	Separator string
+	PreText   string
+	PostText  string
+	JoinText  string
+	Data2Used bool
+	TextError string
 }
 
 type NameDetailsFull struct {
 	NameDetails
-	Rule    []*ComponentNameRule
-	Parents []*Component
+	Rule       []*ComponentNameRule
+	Parents    []*Component
+	TracedFrom *Component   // set if the name came from a traced name rule
+	Sources    []*Component // the components whose data was used to build the name
 }
 
 func (n NameDetailsFull) String() string {
@@ -97,6 +113,13 @@ func (n NameDetailsFull) OriginComponent() *Component {
 }
 
 func (n *ComponentDb) GetNameFull(alias string) (*NameDetailsFull, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.getNameFull(alias, nil)
+}
+
+// getNameFull generates the name for the component, if trace is not nil each rule evaluated is recorded in it
+func (n *ComponentDb) getNameFull(alias string, trace *NameExplanation) (*NameDetailsFull, error) {
 
 	ruleName, nameRules, err := n.GetNameRulesForComponent(alias)
 	if err != nil {
@@ -108,13 +131,28 @@ func (n *ComponentDb) GetNameFull(alias string) (*NameDetailsFull, error) {
 		return nil, fmt.Errorf("error getting parents for component %s, %w", alias, err)
 	}
 
-	name := n.getNameFromRules(parents, nameRules)
+	if n.tracedNaming && ruleName == DefaultNameRuleName {
+		tracedRuleName, tracedRules, source, ok := n.getTracedNameRules(parents)
+		if ok {
+			if trace != nil {
+				trace.Notes = append(trace.Notes, fmt.Sprintf("Traced name rule %s from %s", tracedRuleName, source.ComponentAlias))
+			}
+			name := n.getNameFromRules(parents, tracedRules, trace)
+			name.RuleName = TracedRulePrefix + tracedRuleName
+			name.TracedFrom = source
+			name.Sources = append(name.Sources, source)
+			return name, nil
+		}
+	}
+
+	name := n.getNameFromRules(parents, nameRules, trace)
 	return name, nil
 }
 
 func (n *ComponentDb) GetName(alias string) (*NameDetails, error) {
-
-	name, err := n.GetNameFull(alias)
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	name, err := n.getNameFull(alias, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error getting name for component %s, %w", alias, err)
 	}
@@ -122,29 +160,10 @@ func (n *ComponentDb) GetName(alias string) (*NameDetails, error) {
 	return &name.NameDetails, nil
 }
 
-// ResolveNames resolves the names for all components
-// It sets the Name field for each component
-// It also builds the componentsByName map
-func (n *ComponentDb) ResolveNames() {
-
-	for _, component := range n.Components.componentsByAlias {
-
-		name, err := n.GetName(component.ComponentAlias)
-		if err != nil {
-			continue
-		}
-		if name.Name == "" {
-			continue
-		}
-		component.Name = name.Name
-	}
-	n.Components.BuildComponentByName()
-}
-
-func (n *ComponentDb) getNameFromRules(parents []*Component, nameRules []*ComponentNameRule) *NameDetailsFull {
+func (n *ComponentDb) getNameFromRules(parents []*Component, nameRules []*ComponentNameRule, trace *NameExplanation) *NameDetailsFull {
 
 	if len(nameRules) == 0 {
-		nameRules = DefaultNameRules()
+		nameRules = n.defaultNameRules()
 	}
 
 	if nameRules[0].TextLocation == NameRuleParent {
@@ -153,12 +172,15 @@ func (n *ComponentDb) getNameFromRules(parents []*Component, nameRules []*Compon
 
 	var nameFull NameDetailsFull
 
-	location := n.getPartName(nameRules, parents, NameRuleLocation)
-	circuit := n.getPartName(nameRules, parents, NameRuleCircuit)
-	plant := n.getPartName(nameRules, parents, NameRulePlant)
-	origin := n.getPartName(nameRules, parents, NameRuleOrigin)
+	location := n.getPartName(nameRules, parents, NameRuleLocation, trace)
+	circuit := n.getPartName(nameRules, parents, NameRuleCircuit, trace)
+	plant := n.getPartName(nameRules, parents, NameRulePlant, trace)
+	origin := n.getPartName(nameRules, parents, NameRuleOrigin, trace)
 
 	if location.Name == "" {
+		if trace != nil {
+			trace.Notes = append(trace.Notes, "No location found, using the alias of the component")
+		}
 		location.Name = parents[0].ComponentAlias
 		location.Details = append(location.Details, &NamePartDetails{
 			Comp:          parents[0],
@@ -176,6 +198,7 @@ func (n *ComponentDb) getNameFromRules(parents []*Component, nameRules []*Compon
 	nameFull.Plant = n.convertNamePart(plant)
 	nameFull.Origin = n.convertNamePart(origin)
 	nameFull.Parents = parents
+	nameFull.Sources = getNameSources(location, circuit, plant, origin)
 
 	// Join circuit and plant and origin, if plant is not empty do not add origin
 	// use space as separator
@@ -223,6 +246,28 @@ func (n *ComponentDb) getNameFromRules(parents []*Component, nameRules []*Compon
 	return &nameFull
 }
 
+// getNameSources returns the distinct components used by the name parts, including the components the rules
+// consulted that gave no value, as a change to them, such as adding a missing attribute, can change the name
+func getNameSources(parts ...*NamePart) []*Component {
+	sources := []*Component{}
+	seen := make(map[*Component]bool)
+	add := func(comp *Component) {
+		if comp != nil && !seen[comp] {
+			seen[comp] = true
+			sources = append(sources, comp)
+		}
+	}
+	for _, part := range parts {
+		for _, detail := range part.Details {
+			add(detail.Comp)
+		}
+		for _, comp := range part.Consulted {
+			add(comp)
+		}
+	}
+	return sources
+}
+
 // convertNamePart converts a NamePart to a NamePartResponse
 // Note this does not populat the Used flag
 func (n *ComponentDb) convertNamePart(namePart *NamePart) *NamePartResponse {
@@ -232,7 +277,17 @@ func (n *ComponentDb) convertNamePart(namePart *NamePart) *NamePartResponse {
 	namePartResponse := &NamePartResponse{Value: namePart.Name, Alias: namePart.Details[0].Comp.ComponentAlias}
 	for _, detail := range namePart.Details {
 		source := n.getSourceDescription(detail)
-		namePartResponse.Details = append(namePartResponse.Details, &NamePartDetailsResponse{Value: detail.Value, Source: source, RawValue: detail.RawValue, Separator: detail.Separator})
+		namePartResponse.Details = append(namePartResponse.Details, &NamePartDetailsResponse{
+			Value:     detail.Value,
+			Source:    source,
+			RawValue:  detail.RawValue,
+			Separator: detail.Separator,
+			PreText:   detail.PreText,
+			PostText:  detail.PostText,
+			JoinText:  detail.JoinText,
+			Data2Used: detail.Data2Used,
+			TextError: detail.TextError,
+		})
 	}
 	return namePartResponse
 }
@@ -254,39 +309,56 @@ func (n *ComponentDb) getSourceDescription(detail *NamePartDetails) string {
 		}
 	} else if detail.LocationType == NLAttrubuteOrigin {
 		source = "AttrOrigin: " + detail.AttributeName
+	} else if detail.LocationType == NLData {
+		source = "Data"
+	} else if detail.LocationType == NLVoltage {
+		source = "Voltage: " + detail.Comp.ComponentAlias
+	} else if detail.LocationType == NLUserReference {
+		source = "UserRef: " + detail.Comp.ComponentAlias
 	} else {
 		source = "Unknown"
 	}
 	return source
 }
 
-func (n *ComponentDb) getPartName(ruleSet []*ComponentNameRule, parents []*Component, textLocation TextLocationType) *NamePart {
+func (n *ComponentDb) getPartName(ruleSet []*ComponentNameRule, parents []*Component, textLocation TextLocationType, trace *NameExplanation) *NamePart {
 
 	rules := getPartRules(ruleSet, textLocation)
 
 	var forceComponent *Component
 	partName := ""
 	var namePartDetails []*NamePartDetails
+	var consulted []*Component
 	for _, rule := range rules {
 		var namePartDetail *NamePartDetails
+		var step *NameTraceStep
+		if trace != nil {
+			step = trace.addStep(textLocation, rule)
+		}
 
-		namePartDetail, forceComponent = n.getNameForRule(parents, rule, forceComponent)
+		namePartDetail, forceComponent = n.getNameForRule(parents, rule, forceComponent, step, &consulted)
 		if namePartDetail == nil {
 			continue
 		}
 		if rule.UseIfNotFound && partName != "" {
+			if step != nil {
+				step.SkippedUseIfNotFound = true
+			}
 			continue
 		}
-		if partName != "" { // TODO: check if there is a space on the end currently and not add another one
-			partName += " "
+		if partName != "" && rule.UseSeparator { // TODO: check if there is a space on the end currently and not add another one
+			namePartDetail.JoinText = " "
 		}
 
-		partName += namePartDetail.Value
+		partName += namePartDetail.JoinText + namePartDetail.Value
 		namePartDetails = append(namePartDetails, namePartDetail)
+		if step != nil {
+			step.Value = namePartDetail.Value
+		}
 
 	}
 
-	return &NamePart{partName, namePartDetails}
+	return &NamePart{partName, namePartDetails, consulted}
 }
 
 // getNameRulesForParent adds rules to the name rules list this is used
@@ -300,12 +372,14 @@ func getNameRulesForParent(nameRules []*ComponentNameRule) []*ComponentNameRule
 		TextType:     NameRuleTextTypeAttributeElseName,
 		Data:         "Not Applicable",
 		PostText:     "",
+		UseSeparator: true,
 	}
 
 	locationAbbreviationRule := &ComponentNameRule{
 		TextLocation: NameRuleLocation,
 		TextType:     NameRuleTextTypeAbbriviation,
 		Data:         "Not Applicable",
+		UseSeparator: true,
 	}
 
 	// locationAliasOriginRule := &ComponentNameRule{
@@ -320,6 +394,7 @@ func getNameRulesForParent(nameRules []*ComponentNameRule) []*ComponentNameRule
 		TextType:            NameRuleTextTypeAttributeElseName,
 		Data:                "Circuit Name",
 		UseParentIfNotFound: true,
+		UseSeparator:        true,
 	}
 
 	nameRules = append([]*ComponentNameRule{
@@ -339,6 +414,7 @@ func DefaultNameRules() []*ComponentNameRule {
 		TextType:     NameRuleTextTypeAttributeElseName,
 		Data:         "Not Applicable",
 		PostText:     "",
+		UseSeparator: true,
 	}
 
 	locationAbbreviationRule := &ComponentNameRule{
@@ -346,6 +422,7 @@ func DefaultNameRules() []*ComponentNameRule {
 		TextLocation: NameRuleLocation,
 		TextType:     NameRuleTextTypeAbbriviation,
 		Data:         "Not Applicable",
+		UseSeparator: true,
 	}
 
 	// locationAliasOriginRule := &ComponentNameRule{
@@ -362,6 +439,7 @@ func DefaultNameRules() []*ComponentNameRule {
 		Data:         "Circuit Name",
 		// UseParentIfNotFound: true,
 		UseOriginIfNotFound: true,
+		UseSeparator:        true,
 	}
 
 	// circuitNameRule2 := &ComponentNameRule{
@@ -386,6 +464,7 @@ func DefaultNameRules() []*ComponentNameRule {
 		TextType:            NameRuleTextTypeAttributeOriginIfDifferentElseName,
 		Data:                "Circuit Name", // Not
 		UseParentIfNotFound: true,
+		UseSeparator:        true,
 	}
 
 	nameRules := []*ComponentNameRule{
@@ -415,7 +494,8 @@ func getPartRules(nameRules []*ComponentNameRule, textLocation TextLocationType)
 	return locationRules
 }
 
-func (n *ComponentDb) getNameForRule(parents []*Component, rule *ComponentNameRule, forceComponent *Component) (*NamePartDetails, *Component) {
+// getNameForRule evaluates the rule, the components it reads from are added to consulted
+func (n *ComponentDb) getNameForRule(parents []*Component, rule *ComponentNameRule, forceComponent *Component, step *NameTraceStep, consulted *[]*Component) (*NamePartDetails, *Component) {
 
 	var comp *Component
 
@@ -429,12 +509,21 @@ func (n *ComponentDb) getNameForRule(parents []*Component, rule *ComponentNameRu
 		comp = forceComponent
 	}
 
+	if step != nil {
+		step.recordComponent(rule, parents, comp, forceComponent != nil)
+	}
+
 	if comp == nil {
 		return nil, nil
 	}
+	*consulted = append(*consulted, comp, fallback)
 
 	namePartDetails, forceComponentFlag := n.getNameValue(rule, comp, fallback, parents[0])
 
+	if step != nil {
+		step.recordValue(rule, namePartDetails, forceComponentFlag)
+	}
+
 	if forceComponentFlag {
 		return nil, comp
 	}
@@ -443,9 +532,15 @@ func (n *ComponentDb) getNameForRule(parents []*Component, rule *ComponentNameRu
 		return nil, nil
 	}
 
+	if rule.PreText != "" {
+		namePartDetails.Value = rule.PreText + namePartDetails.Value
+		namePartDetails.PreText = rule.PreText
+	}
+
 	if rule.PostText != "" {
 		namePartDetails.Value += rule.PostText
 		namePartDetails.Separator = rule.PostText
+		namePartDetails.PostText = rule.PostText
 	}
 
 	return namePartDetails, nil
@@ -456,7 +551,7 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 
 	switch r.TextType {
 	case NameRuleTextTypeAttributeElseName: //Attribute Value ELSE Network Device Name
-		attr, err := n.GetComponentAttribute(comp.ComponentID, r.Data)
+		attr, data2Used, err := n.getRuleAttribute(comp.ComponentID, r)
 		if err == nil {
 			if attr.AttributeValue == "" {
 				return &NamePartDetails{
@@ -469,17 +564,21 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 			}
 			attrValue := attr.AttributeValue
 			attrRawValue := attr.AttributeValue
-			if r.Data == "State Alarm Text" {
-				// Parse for string formatting
-				attrValue = strings.ReplaceAll(attrValue, "%%", "%")                    // Replace "%%" with "%"
-				attrValue = regexp.MustCompile(`%[^%]`).ReplaceAllString(attrValue, "") // Remove "%.."
+			var textErr string
+			if isAlarmTextAttribute(attr.AttributeName) {
+				var err error
+				if attrValue, err = renderNameAlarmText(attrValue); err != nil {
+					textErr = err.Error()
+				}
 			}
 			return &NamePartDetails{
 				Comp:          comp,
 				LocationType:  NLAttrubute,
-				AttributeName: r.Data,
+				AttributeName: attr.AttributeName,
 				Value:         attrValue,
 				RawValue:      attrRawValue,
+				Data2Used:     data2Used,
+				TextError:     textErr,
 			}, false
 		}
 		return &NamePartDetails{
@@ -490,28 +589,28 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 			RawValue:      fallback.ComponentPathname,
 		}, false
 	case NameRuleTextTypeAttributeValue: // Attribute Value
-		attr, err := n.GetComponentAttribute(comp.ComponentID, r.Data)
+		attr, data2Used, err := n.getRuleAttribute(comp.ComponentID, r)
 		if err == nil {
 			if attr.AttributeValue == "" {
 				return nil, false
 			}
 			attrValue := attr.AttributeValue
 			attrRawValue := attr.AttributeValue
-			if r.Data == "State Alarm Text" || r.Data == "Supplementary Text" {
-				// Parse for string formatting
-				if strings.Contains(attrValue, "%% ") {
-					attrValue = strings.ReplaceAll(attrValue, "%%", "%")
-				} else {
-					attrValue = regexp.MustCompile(`%[^%]`).ReplaceAllString(attrValue, "") // Remove "%.."
-				} // Replace "%%" with "%"
-
+			var textErr string
+			if isAlarmTextAttribute(attr.AttributeName) {
+				var err error
+				if attrValue, err = renderNameAlarmText(attrValue); err != nil {
+					textErr = err.Error()
+				}
 			}
 			return &NamePartDetails{
 				Comp:          comp,
 				LocationType:  NLAttrubute,
-				AttributeName: r.Data,
+				AttributeName: attr.AttributeName,
 				Value:         attrValue,
 				RawValue:      attrRawValue,
+				Data2Used:     data2Used,
+				TextError:     textErr,
 			}, false
 		}
 
@@ -530,9 +629,44 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 			RawValue:      compClass.ComponentAbbreviation,
 		}, false
 
+	case NameRuleTextTypeData: // Text specified in "Data" followed by "Data2"
+		texts := make([]string, 0, 2)
+		for _, text := range []string{r.Data, r.Data2} {
+			if text != "" {
+				texts = append(texts, text)
+			}
+		}
+		if len(texts) == 0 {
+			return nil, false
+		}
+		text := strings.Join(texts, " ")
+		return &NamePartDetails{
+			Comp:          comp,
+			LocationType:  NLData,
+			AttributeName: "",
+			Value:         text,
+			RawValue:      text,
+			Data2Used:     r.Data2 != "",
+		}, false
+
 	case NameRuleTextTypeForceNextInstruction: // Force next instruction to this component...
 		return nil, true
 
+	case NameRuleTextTypeVoltage: // Voltage level, the attribute named in "Data" (or "Data2"), else the class, else the nearest ancestor with one
+		return n.getVoltageLevel(r, comp), false
+
+	case NameRuleTextTypeUserReference:
+		if comp.UserReference == "" {
+			return nil, false
+		}
+		return &NamePartDetails{
+			Comp:          comp,
+			LocationType:  NLUserReference,
+			AttributeName: "",
+			Value:         comp.UserReference,
+			RawValue:      comp.UserReference,
+		}, false
+
 	case NameRuleTextTypeAlias:
 		return &NamePartDetails{
 			Comp:          comp,
@@ -543,7 +677,7 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 		}, false
 
 	case NameRuleTextTypeAttributeOrigin:
-		attr, err := n.GetComponentAttribute(fallback.ComponentID, r.Data)
+		attr, data2Used, err := n.getRuleAttribute(fallback.ComponentID, r)
 		if err == nil {
 			if attr.AttributeValue == "" {
 				return nil, false
@@ -551,9 +685,10 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 			return &NamePartDetails{
 				Comp:          comp,
 				LocationType:  NLAttrubuteOrigin,
-				AttributeName: r.Data,
+				AttributeName: attr.AttributeName,
 				Value:         attr.AttributeValue,
 				RawValue:      attr.AttributeValue,
+				Data2Used:     data2Used,
 			}, false
 		}
 
@@ -623,6 +758,54 @@ func (n *ComponentDb) getNameValue(r *ComponentNameRule, comp *Component, fallba
 	return nil, false
 }
 
+// getRuleAttribute looks up the attribute named in the rule's Data, if that is missing or
+// empty and the rule has a Data2 the attribute named in Data2 is used instead.
+// The returned bool is true if the Data2 attribute was used.
+func (n *ComponentDb) getRuleAttribute(componentID string, r *ComponentNameRule) (*Attribute, bool, error) {
+	attr, err := n.GetComponentAttribute(componentID, r.Data)
+	if (err != nil || attr.AttributeValue == "") && r.Data2 != "" {
+		attr2, err2 := n.GetComponentAttribute(componentID, r.Data2)
+		if err2 == nil && attr2.AttributeValue != "" {
+			return attr2, true, nil
+		}
+	}
+	return attr, false, err
+}
+
+var classVoltageRegex = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*kV`)
+
+// getVoltageLevel works up the hierarchy from comp looking for a voltage level.
+// At each level the attribute named by the rule is checked first, then the
+// class name (e.g. "132kV Circuit Breaker"). Returns nil if no level has one.
+func (n *ComponentDb) getVoltageLevel(r *ComponentNameRule, comp *Component) *NamePartDetails {
+	for c := comp; c != nil; c = c.Parent {
+		attr, data2Used, err := n.getRuleAttribute(c.ComponentID, r)
+		if err == nil && attr.AttributeValue != "" {
+			return &NamePartDetails{
+				Comp:          c,
+				LocationType:  NLVoltage,
+				AttributeName: attr.AttributeName,
+				Value:         attr.AttributeValue,
+				RawValue:      attr.AttributeValue,
+				Data2Used:     data2Used,
+			}
+		}
+		classDefn, err := n.GetComponentClassDefnByIndex(c.ComponentClass)
+		if err != nil {
+			continue
+		}
+		if match := classVoltageRegex.FindStringSubmatch(classDefn.ComponentClassName); match != nil {
+			return &NamePartDetails{
+				Comp:         c,
+				LocationType: NLVoltage,
+				Value:        match[1] + "kV",
+				RawValue:     classDefn.ComponentClassName,
+			}
+		}
+	}
+	return nil
+}
+
 func (n *ComponentDb) getComponentForRule(r *ComponentNameRule, parents []*Component) *Component {
 	switch r.TextLocation {
 	case NameRuleLocation: // Location
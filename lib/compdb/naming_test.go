@@ -0,0 +1,125 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameRuleDataVoltageUserReference(t *testing.T) {
+	n := newTestNamer(t)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "3", AttributeName: "Voltage Level", AttributeValue: "132kV"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeVoltage, Data: "Voltage Level"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeData, Data: "BKR"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeUserReference},
+	)
+
+	name, err := n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, NORTH 132kV BKR UR-42", name.Name)
+	assert.Equal(t, "Voltage: SUBA/132_CCT/W1/CB", name.Plant.Details[0].Source)
+	assert.Equal(t, "Data", name.Plant.Details[1].Source)
+	assert.Equal(t, "UserRef: SUBA/132_CCT/W1/CB", name.Plant.Details[2].Source)
+}
+
+func TestNameRuleVoltageAndDataUseBothData(t *testing.T) {
+	n := newTestNamer(t)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "2", AttributeName: "Nominal Voltage", AttributeValue: "33kV"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeVoltage, Data: "Voltage Level", Data2: "Nominal Voltage"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeData, Data: "GAS", Data2: "ALARM"},
+	)
+
+	// The voltage is the circuit's Data2 attribute as it has no Data attribute, Data rules give both texts
+	name, err := n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, 33kV GAS ALARM", name.Name)
+	assert.True(t, name.Circuit.Details[0].Data2Used)
+	assert.Equal(t, "Voltage: SUBA/132_CCT/W1", name.Circuit.Details[0].Source)
+
+	// Without the attribute, and with no voltage in the class names or further up, there is no voltage
+	assert.NoError(t, n.DeleteAttribute("SUBA/132_CCT/W1", "Nominal Voltage"))
+	name, err = n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, GAS ALARM", name.Name)
+}
+
+func TestNameRuleVoltageFallsBackToClassThenAncestor(t *testing.T) {
+	n := newTestNamer(t)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "1", AttributeName: "Voltage Level", AttributeValue: "275kV"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeVoltage, Data: "Voltage Level"},
+	)
+
+	// The breaker has no attribute and no voltage in its class, the substation has the attribute
+	name, err := n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, 275kV", name.Name)
+	assert.Equal(t, "Voltage: SUBA", name.Plant.Details[0].Source)
+
+	// The nearest ancestor with a voltage wins, here from the circuit's class
+	n.classDefByIndex[2].ComponentClassName = "33kV Circuit"
+	name, err = n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, 33kV", name.Name)
+	assert.Equal(t, "Voltage: SUBA/132_CCT/W1", name.Plant.Details[0].Source)
+
+	// The component's own class comes before its ancestors
+	n.classDefByIndex[3].ComponentClassName = "11kV Circuit Breaker"
+	name, err = n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, 11kV", name.Name)
+	assert.Equal(t, "Voltage: SUBA/132_CCT/W1/CB", name.Plant.Details[0].Source)
+
+	explain, err := n.ExplainName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Contains(t, explain.String(), "used class voltage of SUBA/132_CCT/W1/CB")
+}
+
+func TestNameRulePreTextSeparatorAndData2(t *testing.T) {
+	n := newTestNamer(t)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "3", AttributeName: "Switch Number", AttributeValue: "105"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAbbriviation},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeValue, Data: "Plant", Data2: "Switch Number", PreText: "(", PostText: ")"},
+	)
+	n.nameRules["TEST CB"][2].UseSeparator = false
+
+	name, err := n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, CB(105)", name.Name)
+
+	detail := name.Plant.Details[1]
+	assert.Equal(t, "(105)", detail.Value)
+	assert.Equal(t, "105", detail.RawValue)
+	assert.Equal(t, "(", detail.PreText)
+	assert.Equal(t, ")", detail.PostText)
+	assert.Equal(t, "", detail.JoinText)
+	assert.True(t, detail.Data2Used)
+	assert.Equal(t, "Attr: Switch Number", detail.Source)
+}
+
+func TestNameRuleAlarmText(t *testing.T) {
+	n := newTestNamer(t)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "3", AttributeName: "State Alarm Text", AttributeValue: "GAS 50%% %s"})
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "3", AttributeName: "Supplementary Text", AttributeValue: "LOW%q"})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "State Alarm Text"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeValue, Data: "Supplementary Text"},
+	)
+
+	// The templates are rendered without a state, unsupported directives are reported with the part
+	name, err := n.GetName("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, GAS 50% LOW", name.Name)
+	assert.Equal(t, "GAS 50%% %s", name.Plant.Details[0].RawValue)
+	assert.Empty(t, name.Plant.Details[0].TextError)
+	assert.Contains(t, name.Plant.Details[1].TextError, "%q")
+}
@@ -0,0 +1,102 @@
+package compdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrMoveCycle is returned when a component is moved under itself or one of its children
+	ErrMoveCycle = errors.New("move would create a cycle")
+	// ErrIllegalPlacement is returned when the placement policy does not allow the component under the new parent
+	ErrIllegalPlacement = errors.New("placement not allowed")
+)
+
+// PlacementRule allows or denies components being placed under a parent. Empty fields match anything,
+// classes are matched by component class name and substation classes by name (e.g. "Primary Substation").
+type PlacementRule struct {
+	ChildClass            string `yaml:"child_class"`
+	ChildSubstationClass  string `yaml:"child_substation_class"`
+	ParentClass           string `yaml:"parent_class"`
+	ParentSubstationClass string `yaml:"parent_substation_class"`
+	Allow                 bool   `yaml:"allow"`
+}
+
+// PlacementPolicy decides which moves are legal. The first rule matching the component and its new parent
+// is used, if no rule matches the move is allowed if DefaultAllow is set.
+type PlacementPolicy struct {
+	DefaultAllow bool            `yaml:"default_allow"`
+	Rules        []PlacementRule `yaml:"rules"`
+}
+
+// ReadPlacementPolicy reads a YAML placement policy file
+func ReadPlacementPolicy(filename string) (*PlacementPolicy, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading placement policy %s: %w", filename, err)
+	}
+	var policy PlacementPolicy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("error parsing placement policy %s: %w", filename, err)
+	}
+	return &policy, nil
+}
+
+// SetPlacementPolicy sets the policy moves are checked against, nil (the default) allows any move that does not create a cycle
+func (n *ComponentDb) SetPlacementPolicy(policy *PlacementPolicy) {
+	n.placementPolicy = policy
+}
+
+// checkCycle returns an error wrapping ErrMoveCycle if the parent is the component or one of its children
+func checkCycle(comp, parent *Component) error {
+	for ancestor := parent; ancestor != nil; ancestor = ancestor.Parent {
+		if ancestor == comp {
+			return fmt.Errorf("%w: %s is %s or one of its children", ErrMoveCycle, parent.ComponentAlias, comp.ComponentAlias)
+		}
+	}
+	return nil
+}
+
+// checkMove returns an error wrapping ErrMoveCycle or ErrIllegalPlacement if the component can not be moved under the parent
+func (n *ComponentDb) checkMove(comp, parent *Component) error {
+	if err := checkCycle(comp, parent); err != nil {
+		return err
+	}
+
+	if n.placementPolicy == nil {
+		return nil
+	}
+	childClass, parentClass := n.className(comp), n.className(parent)
+	childSubstationClass, parentSubstationClass := comp.ComponentSubstationClass.String(), parent.ComponentSubstationClass.String()
+	for _, rule := range n.placementPolicy.Rules {
+		if !placementMatch(rule.ChildClass, childClass) || !placementMatch(rule.ChildSubstationClass, childSubstationClass) ||
+			!placementMatch(rule.ParentClass, parentClass) || !placementMatch(rule.ParentSubstationClass, parentSubstationClass) {
+			continue
+		}
+		if !rule.Allow {
+			return fmt.Errorf("%w: %s (%s, %s) under %s (%s, %s)", ErrIllegalPlacement, comp.ComponentAlias, childClass, childSubstationClass,
+				parent.ComponentAlias, parentClass, parentSubstationClass)
+		}
+		return nil
+	}
+	if !n.placementPolicy.DefaultAllow {
+		return fmt.Errorf("%w: no rule allows %s (%s, %s) under %s (%s, %s)", ErrIllegalPlacement, comp.ComponentAlias, childClass, childSubstationClass,
+			parent.ComponentAlias, parentClass, parentSubstationClass)
+	}
+	return nil
+}
+
+func placementMatch(pattern, value string) bool {
+	return pattern == "" || pattern == value
+}
+
+func (n *ComponentDb) className(comp *Component) string {
+	classDefn, err := n.GetComponentClassDefnByIndex(comp.ComponentClass)
+	if err != nil {
+		return ""
+	}
+	return classDefn.ComponentClassName
+}
@@ -0,0 +1,68 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveComponentChecks(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBB", "SUBB", "0", 1, PrimarySubstation)
+	n.ResolveNames()
+	sub, _ := n.GetComponent("SUBA")
+	circuit, _ := n.GetComponent("SUBA/132_CCT/W1")
+	subB, _ := n.GetComponent("SUBB")
+
+	// Moving a component under itself or one of its children is rejected
+	assert.ErrorIs(t, n.MoveComponent("SUBA", "SUBA"), ErrMoveCycle)
+	assert.ErrorIs(t, n.MoveComponent("SUBA", "SUBA/132_CCT/W1/CB"), ErrMoveCycle)
+	assert.Equal(t, "ROOT", sub.Parent.ComponentAlias)
+
+	// Moves keep the tree, path index and original parent consistent
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBB"))
+	assert.Equal(t, subB, circuit.Parent)
+	assert.Equal(t, sub, circuit.OriginalParent)
+	assert.Equal(t, "4", circuit.ComponentParentID)
+	assert.Contains(t, subB.Children, circuit)
+	assert.NotContains(t, sub.Children, circuit)
+
+	// A component created from a moved template is placed under its own parent, without the template's children
+	created, err := n.CreateComponentReturnComponent("SUBA/132_CCT/W9", "W9", "SUBA", "SUBA/132_CCT/W1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, sub, created.Parent)
+	assert.Nil(t, created.OriginalParent)
+	assert.Empty(t, created.Children)
+	assert.Contains(t, sub.Children, created)
+	assert.NotContains(t, subB.Children, created)
+	assert.NoError(t, n.Rollback())
+	_, ok := n.GetComponentByPath("ROOT:SUBB:W1:CB1")
+	assert.True(t, ok)
+
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBA"))
+	assert.Equal(t, sub, circuit.OriginalParent, "the original parent is kept over several moves")
+
+	assert.NoError(t, n.Rollback())
+	assert.NoError(t, n.Rollback())
+	assert.Equal(t, sub, circuit.Parent)
+	assert.Nil(t, circuit.OriginalParent)
+	assert.Contains(t, sub.Children, circuit)
+	assert.NotContains(t, subB.Children, circuit)
+	_, ok = n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.True(t, ok)
+	_, ok = n.GetComponentByPath("ROOT:SUBB:W1:CB1")
+	assert.False(t, ok)
+
+	// The placement policy only allows circuits under substations
+	addTestComponent(t, n, "5", "SUBB/132_CCT/W2", "W2", "4", 2, PrimaryCircuitID)
+	n.SetPlacementPolicy(&PlacementPolicy{
+		DefaultAllow: true,
+		Rules: []PlacementRule{
+			{ChildClass: "Circuit", ParentClass: "Substation", Allow: true},
+			{ChildClass: "Circuit", Allow: false},
+		},
+	})
+	assert.ErrorIs(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBB/132_CCT/W2"), ErrIllegalPlacement)
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBB"))
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1/CB", "SUBB/132_CCT/W2"))
+}
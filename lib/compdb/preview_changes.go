@@ -0,0 +1,159 @@
+package compdb
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// Change is a mutation to apply with PreviewChanges. The fields used depend on the action:
+//
+//	RenameComponent: Alias, Name
+//	MoveComponent:   Alias, ParentAlias (the new location)
+//	CreateAttribute: Alias, AttributeName, AttributeValue
+//	UpdateAttribute: Alias, AttributeName, AttributeValue
+//	CreateComponent: Alias, Name, ParentAlias, TemplateAlias, SubstationClassName
+//	DeleteComponent: Alias, Recursive
+//	DeleteAttribute: Alias, AttributeName
+type Change struct {
+	Action              RollbackAction
+	Alias               string
+	Name                string
+	ParentAlias         string
+	TemplateAlias       string
+	SubstationClassName string
+	AttributeName       string
+	AttributeValue      string
+	Recursive           bool
+
+	id string // the ID of the component or attribute a redone create had, so it keeps its ID
+}
+
+// NameImpact is a component whose generated name or path is changed by a set of changes
+type NameImpact struct {
+	Alias   string
+	OldName string
+	NewName string
+	OldPath string
+	NewPath string
+	Created bool // the component was created by the changes, so has no old name or path
+	Deleted bool // the component was deleted by the changes, so has no new name or path
+}
+
+type previewState struct {
+	comp *Component
+	name string
+	path string
+}
+
+// PreviewChanges applies the changes, reports the components whose name or path changed and then rolls the
+// changes back, leaving the ComponentDb as it was. If a change fails the changes applied so far are rolled back
+// and the error returned.
+func (n *ComponentDb) PreviewChanges(changes []Change) ([]*NameImpact, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	depth := len(n.rollbackStack)
+	redoStack := n.redoStack
+	before := make(map[string]*previewState)
+	created := make(map[string]bool)
+
+	impacts, err := n.applyPreviewChanges(changes, before, created)
+
+	for len(n.rollbackStack) > depth {
+		if rollbackErr := n.undo(); rollbackErr != nil {
+			return nil, fmt.Errorf("error rolling back preview: %w", rollbackErr)
+		}
+	}
+	n.redoStack = redoStack
+	slog.Info("Namer: PreviewChanges", "changes", len(changes), "impacts", len(impacts), "error", err)
+	if err != nil {
+		return nil, err
+	}
+	return impacts, nil
+}
+
+func (n *ComponentDb) applyPreviewChanges(changes []Change, before map[string]*previewState, created map[string]bool) ([]*NameImpact, error) {
+	for i, change := range changes {
+		// Names only depend on the component and its parents, so the subtree of the changed component
+		// holds every name and path the change can affect
+		if comp, err := n.GetComponent(change.Alias); err == nil {
+			n.recordPreviewState(comp, before)
+		}
+
+		if err := n.applyChange(change); err != nil {
+			return nil, fmt.Errorf("change %d (%s %s): %w", i+1, change.Action, change.Alias, err)
+		}
+
+		if change.Action == CreateComponentAction {
+			if comp, err := n.GetComponent(change.Alias); err == nil {
+				created[change.Alias] = true
+				before[change.Alias] = &previewState{comp: comp}
+			}
+		}
+	}
+
+	impacts := make([]*NameImpact, 0)
+	for alias, state := range before {
+		if n.componentsByAlias[alias] != state.comp {
+			if !created[alias] {
+				impacts = append(impacts, &NameImpact{Alias: alias, OldName: state.name, OldPath: state.path, Deleted: true})
+			}
+			continue
+		}
+		after := n.getPreviewState(state.comp)
+		if !created[alias] && after.name == state.name && after.path == state.path {
+			continue
+		}
+		impacts = append(impacts, &NameImpact{
+			Alias:   alias,
+			OldName: state.name,
+			NewName: after.name,
+			OldPath: state.path,
+			NewPath: after.path,
+			Created: created[alias],
+		})
+	}
+	sort.Slice(impacts, func(i, j int) bool {
+		return impacts[i].Alias < impacts[j].Alias
+	})
+	return impacts, nil
+}
+
+func (n *ComponentDb) applyChange(change Change) error {
+	switch change.Action {
+	case RenameComponentAction:
+		return n.renameComponent(change.Alias, change.Name)
+	case MoveComponentAction:
+		return n.moveComponent(change.Alias, change.ParentAlias)
+	case CreateAttributeAction:
+		return n.createAttribute(change.Alias, change.AttributeName, change.AttributeValue, change.id)
+	case UpdateAttributeAction:
+		return n.updateAttribute(change.Alias, change.AttributeName, change.AttributeValue)
+	case CreateComponentAction:
+		_, err := n.createComponentReturnComponent(change.Alias, change.Name, change.ParentAlias, change.TemplateAlias, change.SubstationClassName, change.id)
+		return err
+	case DeleteComponentAction:
+		return n.deleteComponent(change.Alias, change.Recursive)
+	case DeleteAttributeAction:
+		return n.deleteAttribute(change.Alias, change.AttributeName)
+	}
+	return fmt.Errorf("unknown change action %q", change.Action)
+}
+
+// recordPreviewState records the name and path of the component and its children, unless already recorded
+func (n *ComponentDb) recordPreviewState(comp *Component, before map[string]*previewState) {
+	if _, ok := before[comp.ComponentAlias]; !ok {
+		before[comp.ComponentAlias] = n.getPreviewState(comp)
+	}
+	for _, child := range comp.Children {
+		n.recordPreviewState(child, before)
+	}
+}
+
+func (n *ComponentDb) getPreviewState(comp *Component) *previewState {
+	state := &previewState{comp: comp, path: comp.GetFullPath()}
+	if name, err := n.getNameFull(comp.ComponentAlias, nil); err == nil {
+		state.name = name.Name
+	}
+	return state
+}
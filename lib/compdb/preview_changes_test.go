@@ -0,0 +1,50 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewChanges(t *testing.T) {
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+
+	impacts, err := n.PreviewChanges([]Change{
+		{Action: UpdateAttributeAction, Alias: "SUBA/132_CCT/W1", AttributeName: "Circuit Name", AttributeValue: "SOUTH"},
+		{Action: RenameComponentAction, Alias: "SUBA/132_CCT/W1/CB", Name: "CB9"},
+		{Action: CreateComponentAction, Alias: "SUBA/132_CCT/W1/CB2", Name: "CB2", ParentAlias: "SUBA/132_CCT/W1", TemplateAlias: "SUBA/132_CCT/W1/CB"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*NameImpact{
+		{Alias: "SUBA/132_CCT/W1", OldName: "SUBA SUB, NORTH W1", NewName: "SUBA SUB, SOUTH W1", OldPath: "ROOT:SUBA:W1", NewPath: "ROOT:SUBA:W1"},
+		{Alias: "SUBA/132_CCT/W1/CB", OldName: "SUBA, NORTH CB1", NewName: "SUBA, SOUTH CB9", OldPath: "ROOT:SUBA:W1:CB1", NewPath: "ROOT:SUBA:W1:CB9"},
+		{Alias: "SUBA/132_CCT/W1/CB2", NewName: "SUBA, SOUTH", NewPath: "ROOT:SUBA:W1:CB2", Created: true},
+	}, impacts)
+
+	// The changes are rolled back
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+	assert.Equal(t, "CB1", cb.ComponentPathname)
+	_, err = n.GetComponent("SUBA/132_CCT/W1/CB2")
+	assert.Error(t, err)
+	changes, _ := n.GetNumberOfChanges()
+	assert.Equal(t, 0, changes)
+
+	// A failing change rolls back the changes applied before it
+	_, err = n.PreviewChanges([]Change{
+		{Action: RenameComponentAction, Alias: "SUBA", Name: "SUBX"},
+		{Action: MoveComponentAction, Alias: "SUBA/132_CCT/W1", ParentAlias: "MISSING"},
+	})
+	assert.ErrorContains(t, err, "change 2 (MoveComponent SUBA/132_CCT/W1)")
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+	_, ok := n.GetComponentByPath("ROOT:SUBA:W1:CB1")
+	assert.True(t, ok)
+	changes, _ = n.GetNumberOfChanges()
+	assert.Equal(t, 0, changes)
+}
@@ -0,0 +1,688 @@
+package compdb
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Query runs a component query and returns the matching components as a table:
+//
+//	[select <field>, ... | count [by <field>, ...]] [where <condition>]
+//
+// Fields are alias, id, pathname, path (the full path), name (the generated name), class, substation_class,
+// parent (the parent's alias), user_reference, clone_id and attr("<attribute name>"), an attribute the
+// component does not have is empty. Conditions are combined with and, or, not and brackets:
+//
+//	<field> = "value"     <field> != "value"     equal, or not
+//	<field> ~ "glob"      <field> !~ "glob"      * matches any characters, including / and :, ? one character
+//	has "<attribute name>"                       the component has the attribute
+//	under "<alias>"       above "<alias>"        a descendant, or ancestor, of the component
+//
+// Keywords are not case sensitive, values are quoted with " or '. For example:
+//
+//	select alias, path where substation_class = "Primary Circuit ID" and under "SUBX" and attr("Circuit Name") = ""
+//	count by class where alias ~ "SUBA/*"
+//
+// Without select or count the alias, path and name are returned. Rows are sorted by alias, counts by the by fields.
+// Attributes that are not preloaded are read from the database once for the query, the cheaper side of and and or
+// is evaluated first so attributes are only looked at for the components the other conditions select.
+func (n *ComponentDb) Query(query string) (*QueryResult, error) {
+	q, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.runQuery(q)
+}
+
+// QueryResult is the table returned by a query
+type QueryResult struct {
+	Columns []string
+	Rows    [][]string
+}
+
+type parsedQuery struct {
+	fields []queryField // the columns, or for count the fields to count by
+	count  bool
+	where  queryExpr // nil matches every component
+}
+
+type queryField struct {
+	name string // the field, or "attr"
+	attr string // the attribute name of an attr field
+}
+
+var queryFields = []string{"alias", "id", "pathname", "path", "name", "class", "substation_class", "parent", "user_reference", "clone_id"}
+
+var defaultQueryFields = []queryField{{name: "alias"}, {name: "path"}, {name: "name"}}
+
+func (f queryField) column() string {
+	if f.name == "attr" {
+		return f.attr
+	}
+	return f.name
+}
+
+func (f queryField) value(r *queryRun, comp *Component) string {
+	switch f.name {
+	case "alias":
+		return comp.ComponentAlias
+	case "id":
+		return comp.ComponentID
+	case "pathname":
+		return comp.ComponentPathname
+	case "path":
+		return comp.GetFullPath()
+	case "name":
+		return comp.Name
+	case "class":
+		return r.className(comp)
+	case "substation_class":
+		return comp.ComponentSubstationClass.String()
+	case "parent":
+		return parentAlias(comp)
+	case "user_reference":
+		return comp.UserReference
+	case "clone_id":
+		return comp.ComponentCloneID
+	case "attr":
+		if attr, ok := r.attribute(comp.ComponentID, f.attr); ok {
+			return attr.AttributeValue
+		}
+	}
+	return ""
+}
+
+// queryExpr is a where condition, bind looks up the components it refers to before it is evaluated.
+// cost is how expensive match is, the cheaper side of and and or is evaluated first.
+type queryExpr interface {
+	bind(r *queryRun) error
+	match(r *queryRun, comp *Component) bool
+	cost() int
+}
+
+type andExpr struct{ left, right queryExpr }
+type orExpr struct{ left, right queryExpr }
+type notExpr struct{ expr queryExpr }
+
+type compareExpr struct {
+	field queryField
+	op    string // =, !=, ~ or !~
+	value string
+	glob  *regexp.Regexp // for ~ and !~
+}
+
+type hasExpr struct{ attr string }
+
+type relationExpr struct {
+	relation string // under or above
+	alias    string
+	comp     *Component
+}
+
+func (e *andExpr) cost() int { return e.left.cost() + e.right.cost() }
+
+func (e *orExpr) cost() int { return e.left.cost() + e.right.cost() }
+
+func (e *notExpr) cost() int { return e.expr.cost() }
+
+// attribute lookups cost the most, they may need the database
+func (e *compareExpr) cost() int {
+	switch e.field.name {
+	case "attr":
+		return 3
+	case "path":
+		return 2
+	}
+	return 1
+}
+
+func (e *hasExpr) cost() int { return 3 }
+
+func (e *relationExpr) cost() int { return 1 }
+
+func (e *andExpr) bind(r *queryRun) error {
+	if err := e.left.bind(r); err != nil {
+		return err
+	}
+	return e.right.bind(r)
+}
+
+func (e *andExpr) match(r *queryRun, comp *Component) bool {
+	return e.left.match(r, comp) && e.right.match(r, comp)
+}
+
+func (e *orExpr) bind(r *queryRun) error {
+	if err := e.left.bind(r); err != nil {
+		return err
+	}
+	return e.right.bind(r)
+}
+
+func (e *orExpr) match(r *queryRun, comp *Component) bool {
+	return e.left.match(r, comp) || e.right.match(r, comp)
+}
+
+func (e *notExpr) bind(r *queryRun) error { return e.expr.bind(r) }
+
+func (e *notExpr) match(r *queryRun, comp *Component) bool { return !e.expr.match(r, comp) }
+
+func (e *compareExpr) bind(r *queryRun) error { return nil }
+
+func (e *compareExpr) match(r *queryRun, comp *Component) bool {
+	value := e.field.value(r, comp)
+	switch e.op {
+	case "=":
+		return value == e.value
+	case "!=":
+		return value != e.value
+	case "~":
+		return e.glob.MatchString(value)
+	}
+	return !e.glob.MatchString(value) // !~
+}
+
+func (e *hasExpr) bind(r *queryRun) error { return nil }
+
+func (e *hasExpr) match(r *queryRun, comp *Component) bool {
+	_, ok := r.attribute(comp.ComponentID, e.attr)
+	return ok
+}
+
+func (e *relationExpr) bind(r *queryRun) error {
+	comp, err := r.GetComponent(e.alias)
+	if err != nil {
+		return fmt.Errorf("query: %s %q: %w", e.relation, e.alias, err)
+	}
+	e.comp = comp
+	return nil
+}
+
+func (e *relationExpr) match(r *queryRun, comp *Component) bool {
+	ancestor, descendant := e.comp, comp
+	if e.relation == "above" {
+		ancestor, descendant = comp, e.comp
+	}
+	for parent := descendant.Parent; parent != nil; parent = parent.Parent {
+		if parent == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// queryRun is a query being run, the attributes it uses that are not preloaded are read once for the query
+// rather than looked up for each component
+type queryRun struct {
+	*ComponentDb
+	attributes map[string]map[string]*Attribute // by attribute name then component ID
+}
+
+// loadAttributes reads the attributes the query uses that are looked up on demand
+func (r *queryRun) loadAttributes(q *parsedQuery) error {
+	names := make(map[string]bool)
+	for _, field := range q.fields {
+		if field.name == "attr" {
+			names[field.attr] = true
+		}
+	}
+	queryAttributeNames(q.where, names)
+
+	r.attributes = make(map[string]map[string]*Attribute)
+	if !r.attrLoader.onDemand() {
+		return nil
+	}
+	for name := range names {
+		if r.attrLoader.preloaded[name] {
+			continue
+		}
+		attrs, err := r.attrLoader.getAttributes(name)
+		if err != nil {
+			return fmt.Errorf("query: %w", err)
+		}
+		r.attributes[name] = attrs
+	}
+	return nil
+}
+
+func queryAttributeNames(expr queryExpr, names map[string]bool) {
+	switch e := expr.(type) {
+	case *andExpr:
+		queryAttributeNames(e.left, names)
+		queryAttributeNames(e.right, names)
+	case *orExpr:
+		queryAttributeNames(e.left, names)
+		queryAttributeNames(e.right, names)
+	case *notExpr:
+		queryAttributeNames(e.expr, names)
+	case *compareExpr:
+		if e.field.name == "attr" {
+			names[e.field.attr] = true
+		}
+	case *hasExpr:
+		names[e.attr] = true
+	}
+}
+
+// attribute returns the component's attribute, changes made since loading take precedence over the attributes read for the query
+func (r *queryRun) attribute(componentID, attributeName string) (*Attribute, bool) {
+	loaded, ok := r.attributes[attributeName]
+	if !ok {
+		attr, err := r.GetComponentAttribute(componentID, attributeName)
+		return attr, err == nil
+	}
+	id := AttributeID{ComponentID: componentID, AttributeName: attributeName}
+	if attr, ok := r.Attributes.attr[id]; ok {
+		return attr, true
+	}
+	if r.Attributes.deleted[id] > 0 {
+		return nil, false
+	}
+	attr, ok := loaded[componentID]
+	return attr, ok
+}
+
+func (n *ComponentDb) runQuery(q *parsedQuery) (*QueryResult, error) {
+	r := &queryRun{ComponentDb: n}
+	if err := r.loadAttributes(q); err != nil {
+		return nil, err
+	}
+	if q.where != nil {
+		if err := q.where.bind(r); err != nil {
+			return nil, err
+		}
+	}
+	comps := make([]*Component, 0)
+	for _, comp := range n.componentsByAlias {
+		if q.where == nil || q.where.match(r, comp) {
+			comps = append(comps, comp)
+		}
+	}
+	sort.Slice(comps, func(i, j int) bool { return comps[i].ComponentAlias < comps[j].ComponentAlias })
+
+	result := &QueryResult{Columns: make([]string, 0, len(q.fields)+1), Rows: make([][]string, 0)}
+	for _, field := range q.fields {
+		result.Columns = append(result.Columns, field.column())
+	}
+	if !q.count {
+		for _, comp := range comps {
+			row := make([]string, len(q.fields))
+			for i, field := range q.fields {
+				row[i] = field.value(r, comp)
+			}
+			result.Rows = append(result.Rows, row)
+		}
+		return result, nil
+	}
+
+	result.Columns = append(result.Columns, "count")
+	counts := make(map[string]int)
+	groups := make(map[string][]string)
+	for _, comp := range comps {
+		group := make([]string, len(q.fields))
+		for i, field := range q.fields {
+			group[i] = field.value(r, comp)
+		}
+		key := strings.Join(group, "\x00")
+		counts[key]++
+		groups[key] = group
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		result.Rows = append(result.Rows, append(groups[key], strconv.Itoa(counts[key])))
+	}
+	if len(q.fields) == 0 && len(result.Rows) == 0 {
+		result.Rows = append(result.Rows, []string{"0"})
+	}
+	return result, nil
+}
+
+// queryToken is a keyword or field (ident), a quoted string or punctuation
+type queryToken struct {
+	kind string // ident, string, punct or eof
+	text string
+	pos  int
+}
+
+func lexQuery(query string) ([]queryToken, error) {
+	tokens := make([]queryToken, 0)
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, queryToken{kind: "ident", text: strings.ToLower(string(runes[start:i])), pos: start})
+		case r == '"' || r == '\'':
+			start := i
+			var text strings.Builder
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				text.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("query: unterminated string at position %d", start+1)
+			}
+			i++
+			tokens = append(tokens, queryToken{kind: "string", text: text.String(), pos: start})
+		case r == '!' && i+1 < len(runes) && (runes[i+1] == '=' || runes[i+1] == '~'):
+			tokens = append(tokens, queryToken{kind: "punct", text: string(runes[i : i+2]), pos: i})
+			i += 2
+		case strings.ContainsRune("(),=~", r):
+			tokens = append(tokens, queryToken{kind: "punct", text: string(r), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("query: unexpected %q at position %d", r, i+1)
+		}
+	}
+	return append(tokens, queryToken{kind: "eof", pos: len(runes)}), nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	next   int
+}
+
+func parseQuery(query string) (*parsedQuery, error) {
+	tokens, err := lexQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	q := &parsedQuery{}
+
+	switch {
+	case p.accept("ident", "select"):
+		if q.fields, err = p.fieldList(); err != nil {
+			return nil, err
+		}
+	case p.accept("ident", "count"):
+		q.count = true
+		if p.accept("ident", "by") {
+			if q.fields, err = p.fieldList(); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		q.fields = defaultQueryFields
+	}
+	if p.accept("ident", "where") {
+		if q.where, err = p.orExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek().kind != "eof" {
+		return nil, p.errorf("expected where or the end of the query")
+	}
+	return q, nil
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.next]
+}
+
+// accept consumes the next token if it is the kind and text
+func (p *queryParser) accept(kind, text string) bool {
+	if token := p.peek(); token.kind == kind && token.text == text {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *queryParser) expect(kind, text string) error {
+	if !p.accept(kind, text) {
+		return p.errorf("expected %s", text)
+	}
+	return nil
+}
+
+func (p *queryParser) str() (string, error) {
+	token := p.peek()
+	if token.kind != "string" {
+		return "", p.errorf("expected a quoted value")
+	}
+	p.next++
+	return token.text, nil
+}
+
+func (p *queryParser) errorf(format string, args ...interface{}) error {
+	token := p.peek()
+	found := token.text
+	if token.kind == "eof" {
+		found = "the end of the query"
+	} else if token.kind == "string" {
+		found = strconv.Quote(token.text)
+	}
+	return fmt.Errorf("query: %s at position %d, found %s", fmt.Sprintf(format, args...), token.pos+1, found)
+}
+
+func (p *queryParser) fieldList() ([]queryField, error) {
+	fields := make([]queryField, 0)
+	for {
+		field, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+		if !p.accept("punct", ",") {
+			return fields, nil
+		}
+	}
+}
+
+func (p *queryParser) field() (queryField, error) {
+	if p.accept("ident", "attr") {
+		if err := p.expect("punct", "("); err != nil {
+			return queryField{}, err
+		}
+		attr, err := p.str()
+		if err != nil {
+			return queryField{}, err
+		}
+		return queryField{name: "attr", attr: attr}, p.expect("punct", ")")
+	}
+	token := p.peek()
+	if token.kind == "ident" {
+		for _, name := range queryFields {
+			if token.text == name {
+				p.next++
+				return queryField{name: name}, nil
+			}
+		}
+	}
+	return queryField{}, p.errorf("expected a field (%s or attr(\"name\"))", strings.Join(queryFields, ", "))
+}
+
+func (p *queryParser) orExpr() (queryExpr, error) {
+	left, err := p.andExpr()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("ident", "or") {
+		right, err := p.andExpr()
+		if err != nil {
+			return nil, err
+		}
+		left, right = cheapestFirst(left, right)
+		left = &orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) andExpr() (queryExpr, error) {
+	left, err := p.unaryExpr()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("ident", "and") {
+		right, err := p.unaryExpr()
+		if err != nil {
+			return nil, err
+		}
+		left, right = cheapestFirst(left, right)
+		left = &andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+// cheapestFirst orders the operands of and or or so the cheaper one is evaluated first, the result is the same either way
+func cheapestFirst(left, right queryExpr) (queryExpr, queryExpr) {
+	if right.cost() < left.cost() {
+		return right, left
+	}
+	return left, right
+}
+
+func (p *queryParser) unaryExpr() (queryExpr, error) {
+	switch {
+	case p.accept("ident", "not"):
+		expr, err := p.unaryExpr()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	case p.accept("punct", "("):
+		expr, err := p.orExpr()
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect("punct", ")")
+	case p.accept("ident", "has"):
+		attr, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		return &hasExpr{attr: attr}, nil
+	}
+	for _, relation := range []string{"under", "above"} {
+		if p.accept("ident", relation) {
+			alias, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			return &relationExpr{relation: relation, alias: alias}, nil
+		}
+	}
+	return p.compareExpr()
+}
+
+func (p *queryParser) compareExpr() (queryExpr, error) {
+	field, err := p.field()
+	if err != nil {
+		return nil, err
+	}
+	token := p.peek()
+	if token.kind != "punct" || !strings.Contains(" = != ~ !~ ", " "+token.text+" ") {
+		return nil, p.errorf("expected =, !=, ~ or !~")
+	}
+	p.next++
+	value, err := p.str()
+	if err != nil {
+		return nil, err
+	}
+	expr := &compareExpr{field: field, op: token.text, value: value}
+	if expr.op == "~" || expr.op == "!~" {
+		expr.glob = globRegexp(value)
+	}
+	return expr, nil
+}
+
+// WriteCSV writes the result as CSV with a header row of the columns
+func (r *QueryResult) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(r.Columns); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(r.Rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// WriteJSON writes the result as a JSON array with an object for each row, keyed by column in column order.
+// The count column of a count query is written as a number.
+func (r *QueryResult) WriteJSON(w io.Writer) error {
+	var b bytes.Buffer
+	b.WriteString("[")
+	for i, row := range r.Rows {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n  {")
+		for j, column := range r.Columns {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			key, _ := json.Marshal(column)
+			b.Write(key)
+			b.WriteString(": ")
+			if _, err := strconv.Atoi(row[j]); err == nil && column == "count" && j == len(r.Columns)-1 {
+				b.WriteString(row[j])
+				continue
+			}
+			value, _ := json.Marshal(row[j])
+			b.Write(value)
+		}
+		b.WriteString("}")
+	}
+	if len(r.Rows) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("]\n")
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// Format returns the result as "csv" or "json"
+func (r *QueryResult) Format(format string) ([]byte, error) {
+	var b bytes.Buffer
+	var err error
+	switch strings.ToLower(format) {
+	case "csv":
+		err = r.WriteCSV(&b)
+	case "json":
+		err = r.WriteJSON(&b)
+	default:
+		return nil, fmt.Errorf("unknown query result format %q, expected csv or json", format)
+	}
+	return b.Bytes(), err
+}
+
+// WriteQueryResult writes the result to filename, as JSON if the file has a .json extension otherwise as CSV
+func WriteQueryResult(filename string, result *QueryResult) error {
+	format := "csv"
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		format = "json"
+	}
+	data, err := result.Format(format)
+	if err != nil {
+		return fmt.Errorf("error encoding query result: %w", err)
+	}
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing query result to %s: %w", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package compdb
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBA/132_CCT/W2", "W2", "1", 2, PrimaryCircuitID)
+	addTestComponent(t, n, "5", "SUBB", "SUBB", "0", 1, PrimarySubstation)
+	addTestComponent(t, n, "6", "SUBB/132_CCT/W1", "W1", "5", 2, PrimaryCircuitID)
+	n.Attributes.AddAttribute(&Attribute{ComponentID: "4", AttributeName: "Circuit Name", AttributeValue: ""})
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+
+	result, err := n.Query(`select alias, attr("Circuit Name") where substation_class = "` + PrimaryCircuitID.String() + `" and under "SUBA"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alias", "Circuit Name"}, result.Columns)
+	assert.Equal(t, [][]string{{"SUBA/132_CCT/W1", "NORTH"}, {"SUBA/132_CCT/W2", ""}}, result.Rows)
+
+	result, err = n.Query(`SELECT alias WHERE class = 'Circuit' AND attr("Circuit Name") = "" AND NOT has "Circuit Name"`)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"SUBB/132_CCT/W1"}}, result.Rows)
+
+	result, err = n.Query(`select alias, path where (alias ~ "*/W?" or above "SUBA/132_CCT/W1/CB") and path !~ "ROOT:SUBB*"`)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"ROOT", "ROOT"}, {"SUBA", "ROOT:SUBA"}, {"SUBA/132_CCT/W1", "ROOT:SUBA:W1"}, {"SUBA/132_CCT/W2", "ROOT:SUBA:W2"}}, result.Rows)
+
+	result, err = n.Query(`count by class, parent where under "ROOT"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"class", "parent", "count"}, result.Columns)
+	assert.Equal(t, [][]string{
+		{"Circuit", "SUBA", "2"},
+		{"Circuit", "SUBB", "1"},
+		{"Circuit Breaker", "SUBA/132_CCT/W1", "1"},
+		{"Substation", "ROOT", "2"},
+	}, result.Rows)
+
+	result, err = n.Query(`count where name ~ "*NORTH*"`) // the circuit and its CB
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"count"}, result.Columns)
+	assert.Equal(t, [][]string{{"2"}}, result.Rows)
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Equal(t, "SUBA, NORTH CB1", cb.Name)
+
+	result, err = n.Query(`count where alias = "NONE"`)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"0"}}, result.Rows)
+
+	result, err = n.Query(`where id = "3"`)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"SUBA/132_CCT/W1/CB", "ROOT:SUBA:W1:CB1", cb.Name}}, result.Rows)
+
+	var out strings.Builder
+	result, _ = n.Query(`count by class where under "SUBB"`)
+	assert.NoError(t, result.WriteJSON(&out))
+	assert.Equal(t, "[\n  {\"class\": \"Circuit\", \"count\": 1}\n]\n", out.String())
+	out.Reset()
+	result, _ = n.Query(`select alias, name where alias = "SUBA/132_CCT/W1/CB"`)
+	assert.NoError(t, result.WriteCSV(&out))
+	assert.Equal(t, "alias,name\nSUBA/132_CCT/W1/CB,\"SUBA, NORTH CB1\"\n", out.String())
+
+	for _, query := range []string{
+		`select`,
+		`select colour`,
+		`where alias = SUBA`,
+		`where alias == "SUBA"`,
+		`where alias = "SUBA" and`,
+		`where (alias = "SUBA"`,
+		`where under "NONE"`,
+		`where alias = "SUBA`,
+		`count by`,
+		`select alias order by alias`,
+	} {
+		_, err := n.Query(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestQueryAttributesLoadedOnDemand(t *testing.T) {
+	db, err := sqlx.Open("sqlite", filepath.Join(t.TempDir(), "attrs.db"))
+	assert.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE COMPONENT_ATTRIBUTES (COMPONENT_ID TEXT, ATTRIBUTE_ID TEXT, ATTRIBUTE_NAME TEXT, ATTRIBUTE_INDEX INTEGER, ATTRIBUTE_DEFINITION TEXT,
+		ATTRIBUTE_VALUE TEXT, ATTRIBUTE_TYPE TEXT, ATTRIBUTE_DE_TYPE TEXT, ATTRIBUTE_ALARM_REF TEXT, ATTRIBUTE_STATUS TEXT, ATTRIBUTE_ALARM_INDEX INTEGER)`)
+	assert.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO COMPONENT_ATTRIBUTES (COMPONENT_ID, ATTRIBUTE_ID, ATTRIBUTE_NAME, ATTRIBUTE_VALUE) VALUES
+		('3', 'A1', 'Feeder Number', '42'), ('2', 'A2', 'Feeder Number', '7'), ('1', 'A3', 'Feeder Number', '1')`)
+	assert.NoError(t, err)
+
+	n := newTestNamer(t)
+	n.attrLoader = newAttributeLoader(db, []string{"Circuit Name"}, DefaultAttributeCacheSize)
+	n.ResolveNames()
+
+	// The attribute is read once for the query, changes made since loading are used instead of the database values
+	assert.NoError(t, n.UpdateAttribute("SUBA/132_CCT/W1/CB", "Feeder Number", "43"))
+	assert.NoError(t, n.DeleteAttribute("SUBA", "Feeder Number"))
+	n.attrLoader = newAttributeLoader(db, []string{"Circuit Name"}, DefaultAttributeCacheSize)
+	result, err := n.Query(`select alias, attr("Feeder Number") where has "Feeder Number"`)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"SUBA/132_CCT/W1", "7"}, {"SUBA/132_CCT/W1/CB", "43"}}, result.Rows)
+	assert.Zero(t, n.attrLoader.order.Len(), "no lookups for each component")
+}
+
+func TestQueryCheapestFirst(t *testing.T) {
+	q, err := parseQuery(`where attr("Circuit Name") = "" and (has "Plant" or alias ~ "SUB*") and under "SUBA"`)
+	assert.NoError(t, err)
+	and := q.where.(*andExpr)
+	assert.IsType(t, &relationExpr{}, and.left)
+	or := and.right.(*andExpr).right.(*orExpr)
+	assert.IsType(t, &compareExpr{}, or.left)
+	assert.IsType(t, &hasExpr{}, or.right)
+}
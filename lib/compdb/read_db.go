@@ -1,18 +1,24 @@
 package compdb
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
+	"os"
 	"time"
 )
 
 func ReadDB(filename string) (*ComponentDb, error) {
+	return ReadDBWithOptions(filename, DefaultLoadOptions())
+}
+
+func ReadDBWithOptions(filename string, opts LoadOptions) (*ComponentDb, error) {
 
 	startTime := time.Now() // Start timer
 	fmt.Printf("Reading namer from %s\n", filename)
 	slog.Info("Reading namer from ", "file", filename)
-	compDb, err := LoadCompDb(filename)
+	compDb, err := LoadCompDbWithOptions(filename, opts)
 	if err != nil {
 		fmt.Printf("Error reading database: %s does file exist? %s\n", filename, err)
 		slog.Error("Error reading database", "Error", err, "file", filename)
@@ -24,3 +30,32 @@ func ReadDB(filename string) (*ComponentDb, error) {
 
 	return compDb, nil
 }
+
+// ReadDBWithSnapshot loads the snapshot if it is up to date with the database, otherwise the database is read
+// and the snapshot rewritten so the next start is fast
+func ReadDBWithSnapshot(filename, snapshotFile string, opts LoadOptions) (*ComponentDb, error) {
+	startTime := time.Now()
+	compDb, err := LoadSnapshot(snapshotFile, filename, opts)
+	if err == nil {
+		duration := time.Since(startTime)
+		slog.Info("Completed reading namer from snapshot", "file", snapshotFile, "duration", duration)
+		fmt.Printf("Completed reading namer from snapshot %s in %s\n", snapshotFile, duration)
+		return compDb, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("Not using snapshot: %s\n", err)
+	}
+	slog.Info("Not using snapshot", "file", snapshotFile, "reason", err)
+
+	compDb, err = ReadDBWithOptions(filename, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := compDb.WriteSnapshot(snapshotFile); err != nil {
+		slog.Error("Error writing snapshot", "Error", err, "file", snapshotFile)
+		fmt.Printf("Error writing snapshot: %s\n", err)
+	} else {
+		fmt.Printf("Wrote snapshot %s\n", snapshotFile)
+	}
+	return compDb, nil
+}
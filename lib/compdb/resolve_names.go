@@ -0,0 +1,102 @@
+package compdb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ResolveOptions control how ResolveNamesContext resolves the names
+type ResolveOptions struct {
+	Workers  int                   // number of goroutines generating names, defaults to GOMAXPROCS
+	Progress func(done, total int) // called as names are resolved, from the worker goroutines. If nil progress is logged with slog
+	Interval int                   // number of names between progress reports, defaults to 5% of the components
+}
+
+// resolvedName is the result of generating the name for a component
+type resolvedName struct {
+	name      string
+	sourceIDs []string
+	ok        bool
+}
+
+// ResolveNames resolves the names for all components
+// It sets the Name field for each component
+// It also builds the componentsByName map
+// It also records the components each name was built from so names can be refreshed after a change
+func (n *ComponentDb) ResolveNames() {
+	if err := n.ResolveNamesContext(context.Background(), ResolveOptions{}); err != nil {
+		slog.Error("Namer: failed to resolve names", "error", err)
+	}
+}
+
+// ResolveNamesContext resolves the names for all components using a pool of workers.
+// Generating the names only reads from the ComponentDb (maps are safe for concurrent reads), the results are then
+// applied in alias order so componentsByName is the same however many workers are used.
+// If the context is cancelled no names are changed and the context error is returned.
+func (n *ComponentDb) ResolveNamesContext(ctx context.Context, opts ResolveOptions) error {
+	comps := make([]*Component, 0, len(n.componentsByAlias))
+	for _, comp := range n.componentsByAlias {
+		comps = append(comps, comp)
+	}
+	sort.Slice(comps, func(i, j int) bool { return comps[i].ComponentAlias < comps[j].ComponentAlias })
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = max(len(comps)/20, 1)
+	}
+	startTime := time.Now()
+	progress := opts.Progress
+	if progress == nil {
+		progress = func(done, total int) {
+			slog.Info("Namer: resolving names", "done", done, "total", total, "duration", time.Since(startTime))
+		}
+	}
+
+	results := make([]resolvedName, len(comps))
+	var next, done atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= len(comps) {
+					return
+				}
+				name, sourceIDs, ok := n.computeName(comps[i])
+				results[i] = resolvedName{name: name, sourceIDs: sourceIDs, ok: ok}
+				if count := int(done.Add(1)); count%interval == 0 || count == len(comps) {
+					progress(count, len(comps))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("resolving names cancelled after %d of %d: %w", done.Load(), len(comps), err)
+	}
+
+	n.nameDeps = newNameDependencies()
+	n.Components.componentsByName = make(map[string][]*Component)
+	for i, comp := range comps {
+		comp.Name = results[i].name
+		if results[i].ok {
+			n.nameDeps.set(comp.ComponentID, results[i].sourceIDs)
+		}
+		n.Components.addToNameIndex(comp)
+	}
+	slog.Info("Namer: names resolved", "components", len(comps), "workers", workers, "duration", time.Since(startTime))
+	return nil
+}
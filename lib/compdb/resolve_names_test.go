@@ -0,0 +1,101 @@
+package compdb
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSyntheticNamer builds a network of substations, each with 10 circuits of circuit breakers, with about size components
+func newSyntheticNamer(tb testing.TB, size int) *ComponentDb {
+	tb.Helper()
+	n := NewCompDb()
+	addTestClass(n, 1, "Substation", "SUB", "")
+	addTestClass(n, 2, "Circuit", "CCT", "")
+	addTestClass(n, 3, "Circuit Breaker", "CB", "TEST CB")
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAbbriviation},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Switch Number"},
+	)
+
+	root := &Component{ComponentID: "0", ComponentAlias: "ROOT", ComponentPathname: "ROOT"}
+	n.Components.AddComponentNoHierarchy(root)
+	n.Components.Root = root
+
+	const circuits = 10
+	breakers := 100
+	substations := max(size/(circuits*(breakers+1)), 1)
+	if size < circuits*(breakers+1) {
+		breakers = max(size/circuits-1, 1)
+	}
+	for s := range substations {
+		subID := fmt.Sprintf("S%d", s)
+		subAlias := fmt.Sprintf("SUB%05d", s)
+		addTestComponent(tb, n, subID, subAlias, subAlias, "0", 1, PrimarySubstation)
+		for c := range circuits {
+			cctID := fmt.Sprintf("%s.C%d", subID, c)
+			addTestComponent(tb, n, cctID, fmt.Sprintf("%s/11_CCT/F%d", subAlias, c), fmt.Sprintf("F%d", c), subID, 2, PrimaryCircuitID)
+			n.Attributes.AddAttribute(&Attribute{ComponentID: cctID, AttributeName: "Circuit Name", AttributeValue: fmt.Sprintf("FEEDER %d", c)})
+			for b := range breakers {
+				cbID := fmt.Sprintf("%s.B%d", cctID, b)
+				addTestComponent(tb, n, cbID, fmt.Sprintf("%s/11_CCT/F%d/CB%d", subAlias, c, b), fmt.Sprintf("CB%d", b), cctID, 3, PrimarySubstationComponent)
+				n.Attributes.AddAttribute(&Attribute{ComponentID: cbID, AttributeName: "Switch Number", AttributeValue: fmt.Sprintf("%d", b%7)})
+			}
+		}
+	}
+	return n
+}
+
+func TestResolveNamesDeterministic(t *testing.T) {
+	serial := newSyntheticNamer(t, 5000)
+	parallel := newSyntheticNamer(t, 5000)
+
+	assert.NoError(t, serial.ResolveNamesContext(context.Background(), ResolveOptions{Workers: 1, Progress: func(done, total int) {}}))
+	var progressCalls atomic.Int32
+	assert.NoError(t, parallel.ResolveNamesContext(context.Background(), ResolveOptions{Workers: 8, Interval: 1000, Progress: func(done, total int) { progressCalls.Add(1) }}))
+	assert.Equal(t, int32(5), progressCalls.Load()) // 4045 components, reports at 1000..4000 and at the end
+
+	assert.Equal(t, len(serial.componentsByName), len(parallel.componentsByName))
+	for name, comps := range serial.componentsByName {
+		assert.Len(t, parallel.componentsByName[name], len(comps))
+		for i, comp := range comps {
+			assert.Equal(t, comp.ComponentAlias, parallel.componentsByName[name][i].ComponentAlias)
+		}
+	}
+	comp, _ := parallel.GetComponent("SUB00002/11_CCT/F3/CB12")
+	assert.Equal(t, "SUB00002, FEEDER 3 CB 5", comp.Name)
+}
+
+func TestResolveNamesCancelled(t *testing.T) {
+	n := newSyntheticNamer(t, 2000)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := n.ResolveNamesContext(ctx, ResolveOptions{})
+	assert.ErrorIs(t, err, context.Canceled)
+	comp, _ := n.GetComponent("SUB00000/11_CCT/F0/CB0")
+	assert.Equal(t, "", comp.Name)
+}
+
+// BenchmarkResolveNames resolves a million component network with increasing numbers of workers, up to the number of CPUs
+func BenchmarkResolveNames(b *testing.B) {
+	n := newSyntheticNamer(b, 1_000_000)
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		if workers > 1 && workers > runtime.NumCPU() {
+			break
+		}
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for b.Loop() {
+				if err := n.ResolveNamesContext(context.Background(), ResolveOptions{Workers: workers, Progress: func(done, total int) {}}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
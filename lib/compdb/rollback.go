@@ -13,6 +13,8 @@ const (
 	UpdateAttributeAction RollbackAction = "UpdateAttribute"
 	CreateAttributeAction RollbackAction = "CreateAttribute"
 	CreateComponentAction RollbackAction = "CreateComponent"
+	DeleteComponentAction RollbackAction = "DeleteComponent"
+	DeleteAttributeAction RollbackAction = "DeleteAttribute"
 )
 
 type RollbackOperation struct {
@@ -21,7 +23,49 @@ type RollbackOperation struct {
 	OldState interface{} // Store the old state of the component or attribute
 }
 
+// Rollback undoes the last change. It can be re-applied with Redo until another change is made.
 func (n *ComponentDb) Rollback() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.rollback()
+}
+
+func (n *ComponentDb) rollback() error {
+	if len(n.rollbackStack) <= n.sessionBase { // a session can not roll back the shared changes
+		return fmt.Errorf("no operations to rollback")
+	}
+
+	redo := n.redoChange(n.rollbackStack[len(n.rollbackStack)-1])
+	if err := n.undo(); err != nil {
+		return err
+	}
+	n.redoStack = append(n.redoStack, redo)
+	n.dropSavepoints(len(n.rollbackStack))
+	return nil
+}
+
+// pushRollback records a change so it can be rolled back, a new change clears the redo stack
+func (n *ComponentDb) pushRollback(op RollbackOperation) {
+	n.rollbackStack = append(n.rollbackStack, op)
+	if !n.redoing {
+		n.redoStack = nil
+	}
+}
+
+// undoTo reverts the changes made since depth for a rejected change, and restores the redo stack
+// it cleared so a rejected change leaves nothing behind
+func (n *ComponentDb) undoTo(depth int, redoStack []Change) error {
+	for len(n.rollbackStack) > depth {
+		if err := n.undo(); err != nil {
+			return fmt.Errorf("error rolling back rejected change: %w", err)
+		}
+	}
+	n.redoStack = redoStack
+	return nil
+}
+
+// undo reverts the last change without recording it for Redo
+func (n *ComponentDb) undo() error {
 	if len(n.rollbackStack) == 0 {
 		return fmt.Errorf("no operations to rollback")
 	}
@@ -37,7 +81,13 @@ func (n *ComponentDb) Rollback() error {
 			return fmt.Errorf("Rollback: Rename. Failed to get component %s: %w", lastOp.Alias, err)
 		}
 		slog.Info("Rollback: Rename. Restoring old name", "alias", lastOp.Alias, "oldName", lastOp.OldState.(string))
+		n.Components.removePathIndex(comp)
 		comp.ComponentPathname = lastOp.OldState.(string)
+		n.Components.addPathIndex(comp)
+		if comp.Parent != nil {
+			comp.Parent.SortChildren()
+		}
+		n.refreshDependentNames(comp)
 	case MoveComponentAction:
 		comp, err := n.GetComponent(lastOp.Alias)
 		if err != nil {
@@ -45,7 +95,16 @@ func (n *ComponentDb) Rollback() error {
 			return fmt.Errorf("Rollback: Move. Failed to get component %s: %w", lastOp.Alias, err)
 		}
 		slog.Info("Rollback: Move. Restoring parent ID", "alias", lastOp.Alias, "oldParentID", lastOp.OldState.(string))
-		comp.ComponentParentID = lastOp.OldState.(string)
+		oldParentID := lastOp.OldState.(string)
+		oldParent, _ := n.Components.GetComponentByID(oldParentID) // nil if the component had no parent
+		n.Components.removePathIndex(comp)
+		n.Components.setParent(comp, oldParent)
+		comp.ComponentParentID = oldParentID
+		if comp.Parent == comp.OriginalParent {
+			comp.OriginalParent = nil // back under its original parent
+		}
+		n.Components.addPathIndex(comp)
+		n.refreshSubtreeNames(comp)
 	case UpdateAttributeAction:
 		attrNameValue := lastOp.OldState.(AttributeNameValue)
 		slog.Info("Rollback: UpdateAttribute. Updating attribute", "alias", lastOp.Alias, "attrName", attrNameValue.Name, "attrValue", attrNameValue.Value)
@@ -60,28 +119,43 @@ func (n *ComponentDb) Rollback() error {
 			return fmt.Errorf("Rollback: UpdateAttribute. Failed to get attribute %s: %w", attrNameValue.Name, err)
 		}
 		attr.AttributeValue = attrNameValue.Value
+		n.refreshDependentNames(comp)
 	case CreateAttributeAction:
 		newAttr := lastOp.OldState.(*Attribute)
 		// Logic to remove the created attribute
-		attrRefID := AttributeID{
-			ComponentID:   newAttr.ComponentID,
-			AttributeName: newAttr.AttributeName,
-		}
 		comp, err := n.GetComponent(lastOp.Alias)
 		if err != nil {
 			slog.Error("Rollback: CreateAttribute. Failed to get component", "alias", lastOp.Alias, "error", err)
 			return fmt.Errorf("Rollback: CreateAttribute. Failed to get component %s: %w", lastOp.Alias, err)
 		}
 		slog.Info("Rollback: CreateAttribute. Removing attribute", "alias", lastOp.Alias, "attrName", newAttr.AttributeName, "compID", comp.ComponentID)
-		delete(n.Attributes.attr, attrRefID)
+		n.Attributes.DeleteAttribute(newAttr.ComponentID, newAttr.AttributeName)
+		n.refreshDependentNames(comp)
 	case CreateComponentAction:
-		newComp := lastOp.OldState.(*Component)
+		newComp := lastOp.OldState.(*createdComponent).comp
 		slog.Info("Rollback: CreateNewComp. Removing component", "alias", lastOp.Alias, "ID", newComp.ComponentID)
+		n.forgetName(newComp)
 		err := n.Components.RemoveComponent(newComp.ComponentID)
 		if err != nil {
 			slog.Error("Rollback: CreateNewComp. Failed to remove component", "alias", lastOp.Alias, "error", err)
 			return fmt.Errorf("Rollback: CreateNewComp. Failed to remove component %s: %w", lastOp.Alias, err)
 		}
+	case DeleteComponentAction:
+		deleted := lastOp.OldState.(*deletedComponent)
+		slog.Info("Rollback: DeleteComponent. Restoring component", "alias", lastOp.Alias, "components", len(deleted.subtree), "attributes", len(deleted.attributes))
+		n.restoreSubtree(deleted)
+	case DeleteAttributeAction:
+		attr := lastOp.OldState.(*Attribute)
+		slog.Info("Rollback: DeleteAttribute. Restoring attribute", "alias", lastOp.Alias, "attrName", attr.AttributeName)
+		attrID := AttributeID{ComponentID: attr.ComponentID, AttributeName: attr.AttributeName}
+		n.Attributes.AddAttribute(attr)
+		n.Attributes.deleted[attrID]--
+		if n.Attributes.deleted[attrID] <= 0 {
+			delete(n.Attributes.deleted, attrID)
+		}
+		if comp, err := n.GetComponent(lastOp.Alias); err == nil {
+			n.refreshDependentNames(comp)
+		}
 	}
 
 	return nil
@@ -89,16 +163,18 @@ func (n *ComponentDb) Rollback() error {
 
 // Implement RollbackAll method
 func (n *ComponentDb) RollbackAll() error {
-	if len(n.rollbackStack) == 0 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.rollbackStack) <= n.sessionBase {
 		return fmt.Errorf("no operations to Rollback")
 	}
 
-	slog.Info("Namer: RollbackAll", "numberOfChanges", len(n.rollbackStack))
+	slog.Info("Namer: RollbackAll", "numberOfChanges", len(n.rollbackStack)-n.sessionBase)
 	count := 0
-	for len(n.rollbackStack) > 0 {
+	for len(n.rollbackStack) > n.sessionBase {
 		count++
 		slog.Info("RollbackAll", "count", count)
-		if err := n.Rollback(); err != nil {
+		if err := n.rollback(); err != nil {
 			return fmt.Errorf("error during rollback: %w", err)
 		}
 	}
@@ -106,22 +182,29 @@ func (n *ComponentDb) RollbackAll() error {
 	return nil
 }
 
+// SetRollbackPoint sets an unnamed savepoint, see SetSavepoint
 func (n *ComponentDb) SetRollbackPoint() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
 	slog.Info("Setting rollback point")
-	n.rollbackPoint = len(n.rollbackStack)
-	return nil
+	return n.setSavepoint("")
 }
 
+// RollbackToPoint rolls back to the most recent savepoint, named or not. The savepoint is kept so it can be
+// rolled back to again.
 func (n *ComponentDb) RollbackToPoint() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
 	slog.Info("Rolling back to point")
-	for len(n.rollbackStack) > n.rollbackPoint {
-		if err := n.Rollback(); err != nil {
-			return fmt.Errorf("error during rollback: %w", err)
-		}
+	changes := n.sessionBase
+	if len(n.savepoints) > 0 {
+		changes = n.savepoints[len(n.savepoints)-1].Changes
 	}
-	return nil
+	return n.rollbackTo(changes)
 }
 
 func (n *ComponentDb) GetNumberOfChanges() (int, error) {
-	return len(n.rollbackStack), nil
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return len(n.rollbackStack) - n.sessionBase, nil
 }
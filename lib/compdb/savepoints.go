@@ -0,0 +1,160 @@
+package compdb
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Savepoint marks a position in the rollback stack that can be rolled back to by name
+type Savepoint struct {
+	Name    string
+	Changes int // the number of changes when the savepoint was set
+}
+
+// SetSavepoint sets a savepoint at the current change. Savepoints nest, rolling back to or releasing a
+// savepoint also removes the savepoints set after it. Names must be unique, apart from the empty name
+// used by SetRollbackPoint.
+func (n *ComponentDb) SetSavepoint(name string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.setSavepoint(name)
+}
+
+func (n *ComponentDb) setSavepoint(name string) error {
+	if name != "" {
+		if _, ok := n.findSavepoint(name); ok {
+			return fmt.Errorf("savepoint %s already exists", name)
+		}
+	}
+	n.savepoints = append(n.savepoints, Savepoint{Name: name, Changes: len(n.rollbackStack)})
+	slog.Info("Namer: SetSavepoint", "name", name, "changes", len(n.rollbackStack)-n.sessionBase)
+	return nil
+}
+
+// RollbackToSavepoint rolls back the changes made since the savepoint was set. The savepoint is kept,
+// the savepoints set after it are removed.
+func (n *ComponentDb) RollbackToSavepoint(name string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	i, ok := n.findSavepoint(name)
+	if !ok {
+		return fmt.Errorf("no savepoint %s", name)
+	}
+	slog.Info("Namer: RollbackToSavepoint", "name", name, "changes", len(n.rollbackStack)-n.savepoints[i].Changes)
+	if err := n.rollbackTo(n.savepoints[i].Changes); err != nil {
+		return err
+	}
+	n.savepoints = n.savepoints[:i+1]
+	return nil
+}
+
+// ReleaseSavepoint removes the savepoint, and the savepoints set after it, keeping the changes
+func (n *ComponentDb) ReleaseSavepoint(name string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	i, ok := n.findSavepoint(name)
+	if !ok {
+		return fmt.Errorf("no savepoint %s", name)
+	}
+	slog.Info("Namer: ReleaseSavepoint", "name", name, "released", len(n.savepoints)-i)
+	n.savepoints = n.savepoints[:i]
+	return nil
+}
+
+// Savepoints returns the savepoints, oldest first. In a session Changes counts the session's changes.
+func (n *ComponentDb) Savepoints() ([]Savepoint, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return shiftSavepoints(n.savepoints, -n.sessionBase), nil
+}
+
+// findSavepoint returns the index of the most recent savepoint with the name
+func (n *ComponentDb) findSavepoint(name string) (int, bool) {
+	for i := len(n.savepoints) - 1; i >= 0; i-- {
+		if n.savepoints[i].Name == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// dropSavepoints removes the savepoints set after the first changes, they were rolled back
+func (n *ComponentDb) dropSavepoints(changes int) {
+	for len(n.savepoints) > 0 && n.savepoints[len(n.savepoints)-1].Changes > changes {
+		n.savepoints = n.savepoints[:len(n.savepoints)-1]
+	}
+}
+
+// rollbackTo rolls back until there are the given number of changes
+func (n *ComponentDb) rollbackTo(changes int) error {
+	for len(n.rollbackStack) > changes {
+		if err := n.rollback(); err != nil {
+			return fmt.Errorf("error during rollback: %w", err)
+		}
+	}
+	return nil
+}
+
+// Redo re-applies the last change undone by Rollback. The redo stack is cleared by any other change.
+func (n *ComponentDb) Redo() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.redoStack) == 0 {
+		return fmt.Errorf("no operations to redo")
+	}
+	change := n.redoStack[len(n.redoStack)-1]
+
+	n.redoing = true
+	err := n.applyChange(change)
+	n.redoing = false
+	if err != nil {
+		return fmt.Errorf("error redoing %s %s: %w", change.Action, change.Alias, err)
+	}
+	n.redoStack = n.redoStack[:len(n.redoStack)-1]
+	slog.Info("Namer: Redo", "action", change.Action, "alias", change.Alias, "remaining", len(n.redoStack))
+	return nil
+}
+
+func (n *ComponentDb) GetNumberOfRedos() (int, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return len(n.redoStack), nil
+}
+
+// redoChange returns the change that re-applies the operation. It is called before the operation is
+// rolled back, so the component holds the values the operation set.
+func (n *ComponentDb) redoChange(op RollbackOperation) Change {
+	change := Change{Action: op.Action, Alias: op.Alias}
+	if op.Action == DeleteComponentAction {
+		change.Recursive = true // the children were deleted with it
+		return change
+	}
+	comp, err := n.GetComponent(op.Alias)
+	if err != nil {
+		return change
+	}
+	switch op.Action {
+	case RenameComponentAction:
+		change.Name = comp.ComponentPathname
+	case MoveComponentAction:
+		change.ParentAlias = parentAlias(comp)
+	case UpdateAttributeAction:
+		change.AttributeName = op.OldState.(AttributeNameValue).Name
+		if attr, err := n.GetComponentAttribute(comp.ComponentID, change.AttributeName); err == nil {
+			change.AttributeValue = attr.AttributeValue
+		}
+	case CreateAttributeAction:
+		attr := op.OldState.(*Attribute)
+		change.AttributeName, change.AttributeValue = attr.AttributeName, attr.AttributeValue
+		change.id = attr.AttributeID
+	case CreateComponentAction:
+		change.Name = comp.ComponentPathname
+		change.ParentAlias = parentAlias(comp)
+		change.TemplateAlias = op.OldState.(*createdComponent).templateAlias
+		change.SubstationClassName = comp.ComponentSubstationClass.String()
+		change.id = comp.ComponentID
+	case DeleteAttributeAction:
+		change.AttributeName = op.OldState.(*Attribute).AttributeName
+	}
+	return change
+}
@@ -0,0 +1,262 @@
+package compdb
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUnknownSession is returned for a session ID that was never opened, was closed or has expired
+	ErrUnknownSession = errors.New("unknown session")
+	// ErrSessionConflict is returned when a session's changes no longer apply on top of the shared changes
+	ErrSessionConflict = errors.New("session changes conflict with shared changes")
+	// ErrSessionFull is returned for a change in a session that already has the most changes allowed
+	ErrSessionFull = errors.New("session has too many changes")
+)
+
+// DefaultMaxSessionChanges is the number of changes a session can have when used by the name server
+const DefaultMaxSessionChanges = 1000
+
+// Session is a set of changes that are only visible to calls made in the session. Each session has its own
+// rollback stack, savepoints and redo stack. The changes of the sessions are not applied to the ComponentDb
+// at the same time, a session's changes are replayed on top of the shared changes when a call in it is made
+// and undone when a call in another session is made. Switching session costs the undo and replay of both
+// sessions' changes, created components and attributes keep their IDs when replayed. The cost is bounded by
+// SetMaxSessionChanges.
+type Session struct {
+	ID string
+
+	changes    []Change     // the session's changes while it is not active, replayed when it is activated
+	savepoints []Savepoint  // Changes counted from the start of the session
+	redoStack  []Change     // the session's redo stack while it is not active
+	lastUsed   atomic.Int64 // UnixNano of the last call made in the session
+}
+
+func (s *Session) touch() {
+	s.lastUsed.Store(time.Now().UnixNano())
+}
+
+// LastUsed returns when the last call in the session was made
+func (s *Session) LastUsed() time.Time {
+	return time.Unix(0, s.lastUsed.Load())
+}
+
+// SetMaxSessionChanges sets the number of changes a session can have, which bounds the changes replayed when
+// switching to it. A call that changes a session that already has them fails with ErrSessionFull, until
+// changes are rolled back. 0 (the default) is no limit, the shared changes are never limited.
+func (n *ComponentDb) SetMaxSessionChanges(max int) {
+	n.maxSessionChanges = max
+}
+
+// checkSessionChanges returns an error wrapping ErrSessionFull if the active session can not have another change
+func (n *ComponentDb) checkSessionChanges() error {
+	if n.activeSession == nil || n.maxSessionChanges <= 0 {
+		return nil
+	}
+	if changes := len(n.rollbackStack) - n.sessionBase; changes >= n.maxSessionChanges {
+		return fmt.Errorf("%w: session %s has %d changes, roll some back or close the session", ErrSessionFull, n.activeSession.ID, changes)
+	}
+	return nil
+}
+
+// OpenSession opens a session with no changes, its ID is passed to WithSession
+func (n *ComponentDb) OpenSession() (string, error) {
+	n.sessionMu.Lock()
+	defer n.sessionMu.Unlock()
+	session := &Session{ID: uuid.New().String()}
+	session.touch()
+	if n.sessions == nil {
+		n.sessions = make(map[string]*Session)
+	}
+	n.sessions[session.ID] = session
+	slog.Info("Namer: OpenSession", "session", session.ID, "sessions", len(n.sessions))
+	return session.ID, nil
+}
+
+// CloseSession discards the session and its changes
+func (n *ComponentDb) CloseSession(id string) error {
+	n.sessionMu.Lock()
+	defer n.sessionMu.Unlock()
+	session, ok := n.sessions[id]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownSession, id)
+	}
+	n.discardSession(session)
+	slog.Info("Namer: CloseSession", "session", id, "sessions", len(n.sessions))
+	return nil
+}
+
+// ExpireSessions closes the sessions with no calls made in them for maxIdle, returns the IDs of the closed sessions
+func (n *ComponentDb) ExpireSessions(maxIdle time.Duration) []string {
+	n.sessionMu.Lock()
+	defer n.sessionMu.Unlock()
+	expired := make([]string, 0)
+	for id, session := range n.sessions {
+		if time.Since(session.LastUsed()) > maxIdle {
+			n.discardSession(session)
+			expired = append(expired, id)
+			slog.Info("Namer: session expired", "session", id, "lastUsed", session.LastUsed())
+		}
+	}
+	return expired
+}
+
+// WithSession calls fn with the session's changes applied to the ComponentDb, the empty ID is the shared
+// changes made outside any session. fn may be called at the same time as other calls in the same session,
+// the ComponentDb methods lock as usual, but not at the same time as calls in another session.
+// The error wraps ErrSessionConflict if the session's changes no longer apply, as shared changes made since
+// conflict with them. The session keeps its changes, they apply again once the conflicting shared changes
+// are rolled back, or the session can be closed.
+func (n *ComponentDb) WithSession(id string, fn func() error) error {
+	for {
+		n.sessionMu.RLock()
+		session, err := n.lookupSession(id)
+		if err != nil {
+			n.sessionMu.RUnlock()
+			return err
+		}
+		if session == n.activeSession {
+			if session != nil {
+				session.touch()
+			}
+			err := fn()
+			n.sessionMu.RUnlock()
+			return err
+		}
+		n.sessionMu.RUnlock()
+
+		// Another session is active, switch to this one and try again as a call in another session may
+		// switch back before the read lock is taken
+		n.sessionMu.Lock()
+		session, err = n.lookupSession(id)
+		if err == nil && session != n.activeSession {
+			n.mu.Lock()
+			n.deactivateSession()
+			err = n.activateSession(session)
+			n.mu.Unlock()
+		}
+		n.sessionMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// lookupSession returns the session, nil for the empty ID
+func (n *ComponentDb) lookupSession(id string) (*Session, error) {
+	if id == "" {
+		return nil, nil
+	}
+	session, ok := n.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownSession, id)
+	}
+	return session, nil
+}
+
+// discardSession removes the session, undoing its changes if it is active
+func (n *ComponentDb) discardSession(session *Session) {
+	if session == n.activeSession {
+		n.mu.Lock()
+		for len(n.rollbackStack) > n.sessionBase {
+			if err := n.undo(); err != nil {
+				slog.Error("Session: failed to undo change", "session", session.ID, "error", err)
+			}
+		}
+		n.restoreShared()
+		n.mu.Unlock()
+	}
+	delete(n.sessions, session.ID)
+}
+
+// deactivateSession undoes the active session's changes, keeping them in the session to replay later
+func (n *ComponentDb) deactivateSession() {
+	session := n.activeSession
+	if session == nil {
+		return
+	}
+	changes := make([]Change, len(n.rollbackStack)-n.sessionBase)
+	for i := len(changes) - 1; i >= 0; i-- {
+		changes[i] = n.redoChange(n.rollbackStack[len(n.rollbackStack)-1])
+		if err := n.undo(); err != nil {
+			slog.Error("Session: failed to undo change", "session", session.ID, "error", err)
+		}
+	}
+	session.changes = changes
+	session.savepoints = shiftSavepoints(n.savepoints, -n.sessionBase)
+	session.redoStack = n.redoStack
+	n.restoreShared()
+	slog.Info("Namer: session deactivated", "session", session.ID, "changes", len(changes))
+}
+
+// restoreShared makes the shared changes active again
+func (n *ComponentDb) restoreShared() {
+	n.savepoints, n.redoStack = n.sharedSavepoints, n.sharedRedoStack
+	n.sharedSavepoints, n.sharedRedoStack = nil, nil
+	n.activeSession, n.sessionBase = nil, 0
+}
+
+// activateSession replays the session's changes on top of the shared changes. If a change no longer applies,
+// as the shared changes were altered since it was made, the changes replayed are undone, the shared changes
+// stay active and the session keeps all its changes.
+func (n *ComponentDb) activateSession(session *Session) error {
+	if session == nil {
+		return nil
+	}
+	n.sharedSavepoints, n.sharedRedoStack = n.savepoints, n.redoStack
+	n.savepoints, n.redoStack = nil, nil
+	n.activeSession, n.sessionBase = session, len(n.rollbackStack)
+
+	// applied[i] is the number of changes on the session's rollback stack once the first i changes are
+	// replayed, where the savepoints set after them now are
+	applied := make([]int, len(session.changes)+1)
+	n.redoing = true
+	for i, change := range session.changes {
+		err := n.applyChange(change)
+		if err == nil && len(n.rollbackStack)-n.sessionBase == applied[i] {
+			// The change did nothing, e.g. the component was created by a shared change, it would be lost
+			// when the session is next deactivated
+			err = fmt.Errorf("the change has already been made")
+		}
+		if err != nil {
+			n.redoing = false
+			n.abortActivation(session)
+			return fmt.Errorf("%w: session %s %s %s: %w", ErrSessionConflict, session.ID, change.Action, change.Alias, err)
+		}
+		applied[i+1] = len(n.rollbackStack) - n.sessionBase
+	}
+	n.redoing = false
+
+	for _, savepoint := range session.savepoints {
+		n.savepoints = append(n.savepoints, Savepoint{Name: savepoint.Name, Changes: n.sessionBase + applied[savepoint.Changes]})
+	}
+	n.redoStack = session.redoStack
+	session.changes, session.savepoints, session.redoStack = nil, nil, nil
+	slog.Info("Namer: session activated", "session", session.ID, "changes", applied[len(applied)-1])
+	return nil
+}
+
+// abortActivation undoes the session's changes replayed so far, leaving them in the session, and makes the
+// shared changes active again
+func (n *ComponentDb) abortActivation(session *Session) {
+	slog.Warn("Session: changes no longer apply, session not activated", "session", session.ID, "changes", len(session.changes))
+	for len(n.rollbackStack) > n.sessionBase {
+		if err := n.undo(); err != nil {
+			slog.Error("Session: failed to undo change", "session", session.ID, "error", err)
+		}
+	}
+	n.restoreShared()
+}
+
+func shiftSavepoints(savepoints []Savepoint, by int) []Savepoint {
+	shifted := make([]Savepoint, len(savepoints))
+	for i, savepoint := range savepoints {
+		shifted[i] = Savepoint{Name: savepoint.Name, Changes: savepoint.Changes + by}
+	}
+	return shifted
+}
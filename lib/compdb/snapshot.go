@@ -0,0 +1,312 @@
+package compdb
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"sort"
+	"time"
+)
+
+// snapshotMagic starts every snapshot file, it is followed by the snapshot version and the gzipped gob data
+const snapshotMagic = "PSASIM-COMPDB-SNAPSHOT\n"
+
+// SnapshotVersion is the snapshot format written, snapshots of other versions are not read. Increment it
+// whenever the snapshot data, or a struct in it, changes.
+const SnapshotVersion uint32 = 2 // 2: name sources include the components consulted that gave no value
+
+var (
+	// ErrSnapshotStale is returned when the snapshot was not taken of the database, or options, it is loaded with
+	ErrSnapshotStale = errors.New("snapshot is stale")
+	// ErrSnapshotVersion is returned for a snapshot that is not a snapshot, or is of another version
+	ErrSnapshotVersion = errors.New("unsupported snapshot")
+)
+
+// snapshotHeader identifies the database and options a snapshot was taken of, it is read before the data
+// so a stale snapshot is rejected without decoding it
+type snapshotHeader struct {
+	Created        time.Time
+	SourceSize     int64
+	SourceModTime  time.Time
+	SourceHash     string // sha256 of the database file
+	AttributeNames []string
+	Patch          int
+}
+
+type snapshotComponent struct {
+	ID              string
+	Pathname        string
+	Alias           string
+	ParentID        string
+	UserReference   string
+	Class           ComponentClassIndex
+	SubstationClass SubstationType
+	CloneID         string
+	Name            string
+	NameSources     []string // IDs of the components the name was built from, nil if the name was not generated
+}
+
+type snapshotData struct {
+	ClassDefns   []*ComponentClassDefn
+	NameRules    map[string][]*ComponentNameRule
+	Components   []snapshotComponent
+	Attributes   []*Attribute
+	PatchChanges []*PatchChange
+}
+
+// WriteSnapshot writes the ComponentDb, as loaded from the database with resolved names, to the snapshot file.
+// It can only be written before any changes are made, so the snapshot matches the database.
+func (n *ComponentDb) WriteSnapshot(filename string) error {
+	if n.source == "" {
+		return fmt.Errorf("error writing snapshot %s: the component database was not loaded from a database", filename)
+	}
+	if len(n.rollbackStack) > 0 {
+		return fmt.Errorf("error writing snapshot %s: %d changes have been made since loading", filename, len(n.rollbackStack))
+	}
+	startTime := time.Now()
+	header, err := snapshotSource(n.source)
+	if err != nil {
+		return fmt.Errorf("error writing snapshot %s: %w", filename, err)
+	}
+	header.Created = startTime
+	header.AttributeNames = n.loadOptions.AttributeNames
+	header.Patch = n.loadOptions.Patch
+
+	// Write to a temporary file and rename it, so a failed write does not leave a truncated snapshot
+	tmpFile := filename + ".tmp"
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		return fmt.Errorf("error writing snapshot %s: %w", filename, err)
+	}
+	err = n.writeSnapshot(f, header)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile, filename)
+	}
+	if err != nil {
+		os.Remove(tmpFile)
+		return fmt.Errorf("error writing snapshot %s: %w", filename, err)
+	}
+	slog.Info("Namer: snapshot written", "file", filename, "components", len(n.componentsByAlias), "duration", time.Since(startTime))
+	return nil
+}
+
+func (n *ComponentDb) writeSnapshot(w io.Writer, header *snapshotHeader) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(snapshotMagic); err != nil {
+		return err
+	}
+	if err := binary.Write(bw, binary.BigEndian, SnapshotVersion); err != nil {
+		return err
+	}
+	zw, err := gzip.NewWriterLevel(bw, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+	enc := gob.NewEncoder(zw)
+	if err := enc.Encode(header); err != nil {
+		return err
+	}
+	if err := enc.Encode(n.snapshotData()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (n *ComponentDb) snapshotData() *snapshotData {
+	data := &snapshotData{
+		ClassDefns:   make([]*ComponentClassDefn, 0, len(n.classDefByIndex)),
+		NameRules:    n.nameRules,
+		Components:   make([]snapshotComponent, 0, len(n.componentsByAlias)),
+		Attributes:   make([]*Attribute, 0, len(n.Attributes.attr)),
+		PatchChanges: n.patchChanges,
+	}
+	for _, classDefn := range n.classDefByIndex {
+		data.ClassDefns = append(data.ClassDefns, classDefn)
+	}
+	for _, comp := range n.componentsByAlias {
+		snapComp := snapshotComponent{
+			ID:              comp.ComponentID,
+			Pathname:        comp.ComponentPathname,
+			Alias:           comp.ComponentAlias,
+			ParentID:        comp.ComponentParentID,
+			UserReference:   comp.UserReference,
+			Class:           comp.ComponentClass,
+			SubstationClass: comp.ComponentSubstationClass,
+			CloneID:         comp.ComponentCloneID,
+			Name:            comp.Name,
+		}
+		if n.nameDeps != nil {
+			snapComp.NameSources = n.nameDeps.sources[comp.ComponentID]
+		}
+		data.Components = append(data.Components, snapComp)
+	}
+	for _, attr := range n.Attributes.attr {
+		data.Attributes = append(data.Attributes, attr)
+	}
+	return data
+}
+
+// LoadSnapshot loads a ComponentDb from a snapshot of the database. The error wraps ErrSnapshotStale if the
+// database has changed since the snapshot was written, or it was written with other options, and
+// ErrSnapshotVersion if it is not a snapshot this version can read.
+// The database is checked by size and modification time, if they differ by its hash, so a copied database can be used.
+func LoadSnapshot(filename, dbFile string, opts LoadOptions) (*ComponentDb, error) {
+	if len(opts.AttributeNames) == 0 {
+		opts.AttributeNames = DefaultAttributeNames
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot %s: %w", filename, err)
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	magic := make([]byte, len(snapshotMagic))
+	var version uint32
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != snapshotMagic {
+		return nil, fmt.Errorf("%w: %s is not a snapshot", ErrSnapshotVersion, filename)
+	}
+	if err := binary.Read(br, binary.BigEndian, &version); err != nil || version != SnapshotVersion {
+		return nil, fmt.Errorf("%w: %s is version %d, version %d is supported", ErrSnapshotVersion, filename, version, SnapshotVersion)
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot %s: %w", filename, err)
+	}
+	dec := gob.NewDecoder(zr)
+
+	var header snapshotHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf("error reading snapshot %s: %w", filename, err)
+	}
+	if err := checkSnapshotSource(&header, dbFile, opts); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrSnapshotStale, filename, err)
+	}
+
+	var data snapshotData
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("error reading snapshot %s: %w", filename, err)
+	}
+
+	db, err := OpenDB(dbFile)
+	if err != nil {
+		return nil, err
+	}
+	namer := NewCompDb()
+	namer.db = db
+	namer.source = dbFile
+	namer.loadOptions = opts
+	namer.attributeNames = opts.AttributeNames
+	namer.attrLoader = newAttributeLoader(db, namer.attributeNames, opts.AttributeCacheSize)
+	namer.restoreSnapshot(&data)
+	SetComponentClassDefinitions(namer.ComponentClassDefns)
+	slog.Info("Namer: snapshot loaded", "file", filename, "created", header.Created, "components", len(data.Components))
+	return namer, nil
+}
+
+func (n *ComponentDb) restoreSnapshot(data *snapshotData) {
+	for _, classDefn := range data.ClassDefns {
+		n.classDefByName[classDefn.ComponentClassName] = classDefn
+		n.classDefByIndex[classDefn.ComponentClassIndex] = classDefn
+	}
+	if data.NameRules != nil {
+		n.nameRules = data.NameRules
+	}
+
+	// Names are indexed in alias order, as ResolveNames does
+	sort.Slice(data.Components, func(i, j int) bool { return data.Components[i].Alias < data.Components[j].Alias })
+	comps := make([]*Component, len(data.Components))
+	for i, snapComp := range data.Components {
+		comps[i] = &Component{
+			ComponentID:              snapComp.ID,
+			ComponentPathname:        snapComp.Pathname,
+			ComponentAlias:           snapComp.Alias,
+			ComponentParentID:        snapComp.ParentID,
+			UserReference:            snapComp.UserReference,
+			ComponentClass:           snapComp.Class,
+			ComponentSubstationClass: snapComp.SubstationClass,
+			ComponentCloneID:         snapComp.CloneID,
+			Name:                     snapComp.Name,
+		}
+		n.Components.AddComponentNoHierarchy(comps[i])
+	}
+	n.Components.BuildHierarchy()
+
+	n.Components.componentsByName = make(map[string][]*Component)
+	for i, comp := range comps {
+		if data.Components[i].NameSources != nil {
+			n.nameDeps.set(comp.ComponentID, data.Components[i].NameSources)
+		}
+		n.Components.addToNameIndex(comp)
+	}
+	for _, attr := range data.Attributes {
+		n.Attributes.AddAttribute(attr)
+	}
+	n.patchChanges = data.PatchChanges
+}
+
+// snapshotSource returns the size, modification time and hash of the database
+func snapshotSource(dbFile string) (*snapshotHeader, error) {
+	info, err := os.Stat(dbFile)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := fileHash(dbFile)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshotHeader{SourceSize: info.Size(), SourceModTime: info.ModTime(), SourceHash: hash}, nil
+}
+
+// checkSnapshotSource returns an error if the snapshot was not taken of the database with the options
+func checkSnapshotSource(header *snapshotHeader, dbFile string, opts LoadOptions) error {
+	if !slices.Equal(header.AttributeNames, opts.AttributeNames) {
+		return fmt.Errorf("written with other preloaded attributes")
+	}
+	if header.Patch != opts.Patch {
+		return fmt.Errorf("written with patch %d, loading patch %d", header.Patch, opts.Patch)
+	}
+	info, err := os.Stat(dbFile)
+	if err != nil {
+		return err
+	}
+	if info.Size() == header.SourceSize && info.ModTime().Equal(header.SourceModTime) {
+		return nil
+	}
+	hash, err := fileHash(dbFile)
+	if err != nil {
+		return err
+	}
+	if hash != header.SourceHash {
+		return fmt.Errorf("%s has changed since %s", dbFile, header.Created.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func fileHash(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,70 @@
+package compdb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	dbFile := filepath.Join(dir, "source.db")
+	snapshotFile := filepath.Join(dir, "source.snapshot")
+	assert.NoError(t, os.WriteFile(dbFile, []byte("database"), 0o644))
+
+	n := newTestNamer(t)
+	setTestRules(n,
+		&ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"},
+		&ComponentNameRule{TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name"},
+		&ComponentNameRule{TextLocation: NameRulePlant, TextType: NameRuleTextTypeAttributeElseName, Data: "Plant"},
+	)
+	n.ResolveNames()
+	assert.Error(t, n.WriteSnapshot(snapshotFile)) // not loaded from a database
+	n.source, n.loadOptions = dbFile, DefaultLoadOptions()
+	assert.NoError(t, n.WriteSnapshot(snapshotFile))
+
+	loaded, err := LoadSnapshot(snapshotFile, dbFile, DefaultLoadOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, n.Root.ComponentAlias, loaded.Root.ComponentAlias)
+	for alias, comp := range n.componentsByAlias {
+		loadedComp, err := loaded.GetComponent(alias)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, comp.GetFullPath(), loadedComp.GetFullPath())
+		assert.Equal(t, comp.Name, loadedComp.Name)
+		assert.Equal(t, comp.UserReference, loadedComp.UserReference)
+		assert.Equal(t, parentAlias(comp), parentAlias(loadedComp))
+	}
+	matches, _ := loaded.FindComponentsByName("SUBA, NORTH CB1", false, 0)
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "SUBA/132_CCT/W1/CB", matches[0].Alias)
+	}
+
+	// The loaded names are refreshed by changes as if they were resolved
+	assert.NoError(t, loaded.UpdateAttribute("SUBA/132_CCT/W1", "Circuit Name", "SOUTH"))
+	cb, _ := loaded.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Equal(t, "SUBA, SOUTH CB1", cb.Name)
+	assert.Error(t, loaded.WriteSnapshot(snapshotFile)) // changes have been made
+
+	// A copied database has a new modification time but the same hash
+	later := time.Now().Add(time.Hour)
+	assert.NoError(t, os.Chtimes(dbFile, later, later))
+	_, err = LoadSnapshot(snapshotFile, dbFile, DefaultLoadOptions())
+	assert.NoError(t, err)
+
+	opts := DefaultLoadOptions()
+	opts.Patch = AllPatches
+	_, err = LoadSnapshot(snapshotFile, dbFile, opts)
+	assert.ErrorIs(t, err, ErrSnapshotStale)
+
+	assert.NoError(t, os.WriteFile(dbFile, []byte("changed database"), 0o644))
+	_, err = LoadSnapshot(snapshotFile, dbFile, DefaultLoadOptions())
+	assert.ErrorIs(t, err, ErrSnapshotStale)
+
+	_, err = LoadSnapshot(dbFile, dbFile, DefaultLoadOptions())
+	assert.ErrorIs(t, err, ErrSnapshotVersion)
+}
@@ -0,0 +1,181 @@
+package compdb
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SubtreeKind selects leaf components, components with no children, or branch components
+type SubtreeKind string
+
+const (
+	SubtreeAll      SubtreeKind = ""
+	SubtreeLeaves   SubtreeKind = "leaf"
+	SubtreeBranches SubtreeKind = "branch"
+)
+
+// SubtreeFilter selects the components returned by GetSubtree, empty fields match any component.
+// Components that do not match are still walked, so their children can match.
+type SubtreeFilter struct {
+	ComponentClass  string      // component class name, e.g. "Circuit Breaker"
+	SubstationClass string      // substation class name, e.g. "Primary Substation"
+	Kind            SubtreeKind // leaves, branches or both
+	AliasGlob       string      // * matches any characters, including /, and ? one character
+}
+
+// SubtreeComponent is a component in a subtree with its generated name
+type SubtreeComponent struct {
+	ComponentInfo
+	Name        string // empty if the name could not be generated
+	NameError   string // why the name could not be generated, empty if it was
+	Depth       int    // 0 for the component the subtree is of, 1 for its children...
+	ParentAlias string
+}
+
+// ErrSubtreeChanged is returned by GetSubtreePage when the component to continue from is no longer in the subtree
+var ErrSubtreeChanged = errors.New("subtree changed")
+
+// GetSubtree returns the component and its children down to maxDepth levels below it, 0 for no limit, that
+// match the filter. Components are in depth first order, children sorted by pathname.
+func (n *ComponentDb) GetSubtree(alias string, maxDepth int, filter SubtreeFilter) ([]*SubtreeComponent, error) {
+	subtree, _, err := n.GetSubtreePage(alias, maxDepth, filter, "", 0)
+	return subtree, err
+}
+
+// GetSubtreePage returns up to limit components of the subtree, 0 for no limit, starting the walk at the
+// component with alias start, or the top of the subtree if start is empty. next is the alias to start the
+// following page at, empty once the walk is done. The lock is only held for the page, if the start component
+// has been deleted or moved out of the subtree since the previous page an error wrapping ErrSubtreeChanged
+// is returned.
+func (n *ComponentDb) GetSubtreePage(alias string, maxDepth int, filter SubtreeFilter, start string, limit int) (page []*SubtreeComponent, next string, err error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	top, err := n.GetComponent(alias)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting subtree of %s: %w", alias, err)
+	}
+	match, err := filter.matcher(n)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting subtree of %s: %w", alias, err)
+	}
+
+	comp, depth := top, 0
+	if start != "" {
+		comp, depth, err = n.subtreeDepth(top, start, maxDepth)
+		if err != nil {
+			return nil, "", fmt.Errorf("error getting subtree of %s: %w", alias, err)
+		}
+	}
+
+	page = make([]*SubtreeComponent, 0)
+	for ; comp != nil; comp, depth = nextInSubtree(top, comp, depth, maxDepth) {
+		if limit > 0 && len(page) == limit {
+			return page, comp.ComponentAlias, nil
+		}
+		if match(comp) {
+			page = append(page, n.subtreeComponent(comp, depth))
+		}
+	}
+	return page, "", nil
+}
+
+// subtreeDepth returns the component with the alias and its depth below top
+func (n *ComponentDb) subtreeDepth(top *Component, alias string, maxDepth int) (*Component, int, error) {
+	comp, ok := n.componentsByAlias[alias]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: %s not found", ErrSubtreeChanged, alias)
+	}
+	depth := 0
+	for ancestor := comp; ancestor != top; ancestor = ancestor.Parent {
+		if ancestor == nil {
+			return nil, 0, fmt.Errorf("%w: %s is not under %s", ErrSubtreeChanged, alias, top.ComponentAlias)
+		}
+		depth++
+	}
+	if maxDepth > 0 && depth > maxDepth {
+		return nil, 0, fmt.Errorf("%w: %s is more than %d levels under %s", ErrSubtreeChanged, alias, maxDepth, top.ComponentAlias)
+	}
+	return comp, depth, nil
+}
+
+// nextInSubtree returns the component after comp in a depth first walk of the subtree of top, and its depth.
+// Returns nil once the walk is done.
+func nextInSubtree(top, comp *Component, depth, maxDepth int) (*Component, int) {
+	if len(comp.Children) > 0 && (maxDepth == 0 || depth < maxDepth) {
+		return comp.Children[0], depth + 1
+	}
+	for ; comp != top && comp.Parent != nil; comp, depth = comp.Parent, depth-1 {
+		siblings := comp.Parent.Children
+		// Children are sorted by pathname, so search for the component rather than scan every sibling
+		i := sort.Search(len(siblings), func(i int) bool { return siblings[i].ComponentPathname >= comp.ComponentPathname })
+		for ; i < len(siblings) && siblings[i] != comp; i++ {
+		}
+		if i+1 < len(siblings) {
+			return siblings[i+1], depth
+		}
+	}
+	return nil, 0
+}
+
+// subtreeComponent returns the component with its generated name, NameError is set if it could not be generated
+func (n *ComponentDb) subtreeComponent(comp *Component, depth int) *SubtreeComponent {
+	subtreeComp := &SubtreeComponent{
+		ComponentInfo: *n.componentInfo(comp),
+		Depth:         depth,
+		ParentAlias:   parentAlias(comp),
+	}
+	name, err := n.getNameFull(comp.ComponentAlias, nil)
+	if err != nil {
+		subtreeComp.NameError = err.Error()
+	} else {
+		subtreeComp.Name = name.Name
+	}
+	return subtreeComp
+}
+
+// matcher returns a function reporting if a component matches the filter
+func (f SubtreeFilter) matcher(n *ComponentDb) (func(*Component) bool, error) {
+	switch f.Kind {
+	case SubtreeAll, SubtreeLeaves, SubtreeBranches:
+	default:
+		return nil, fmt.Errorf("unknown subtree kind %q, expected %q or %q", f.Kind, SubtreeLeaves, SubtreeBranches)
+	}
+	var aliasGlob *regexp.Regexp
+	if f.AliasGlob != "" {
+		aliasGlob = globRegexp(f.AliasGlob)
+	}
+
+	return func(comp *Component) bool {
+		if f.ComponentClass != "" && n.className(comp) != f.ComponentClass {
+			return false
+		}
+		if f.SubstationClass != "" && comp.ComponentSubstationClass.String() != f.SubstationClass {
+			return false
+		}
+		if f.Kind == SubtreeLeaves && len(comp.Children) > 0 || f.Kind == SubtreeBranches && len(comp.Children) == 0 {
+			return false
+		}
+		return aliasGlob == nil || aliasGlob.MatchString(comp.ComponentAlias)
+	}, nil
+}
+
+// globRegexp converts a glob, where * matches any characters and ? one character, to an anchored regexp
+func globRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
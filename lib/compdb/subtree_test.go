@@ -0,0 +1,100 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubtree(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBA/132_CCT/W2", "W2", "1", 2, PrimaryCircuitID)
+	n.ResolveNames()
+
+	aliases := func(subtree []*SubtreeComponent) []string {
+		result := make([]string, len(subtree))
+		for i, comp := range subtree {
+			result[i] = comp.Alias
+		}
+		return result
+	}
+
+	subtree, err := n.GetSubtree("SUBA", 0, SubtreeFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SUBA", "SUBA/132_CCT/W1", "SUBA/132_CCT/W1/CB", "SUBA/132_CCT/W2"}, aliases(subtree))
+	assert.Equal(t, 2, subtree[2].Depth)
+	assert.Equal(t, "SUBA/132_CCT/W1", subtree[2].ParentAlias)
+	assert.Equal(t, "Circuit Breaker", subtree[2].ComponentClassName)
+	cb, _ := n.GetComponent("SUBA/132_CCT/W1/CB")
+	assert.Equal(t, cb.Name, subtree[2].Name)
+
+	subtree, _ = n.GetSubtree("SUBA", 1, SubtreeFilter{})
+	assert.Equal(t, []string{"SUBA", "SUBA/132_CCT/W1", "SUBA/132_CCT/W2"}, aliases(subtree))
+
+	subtree, _ = n.GetSubtree("ROOT", 0, SubtreeFilter{ComponentClass: "Circuit"})
+	assert.Equal(t, []string{"SUBA/132_CCT/W1", "SUBA/132_CCT/W2"}, aliases(subtree))
+
+	subtree, _ = n.GetSubtree("ROOT", 0, SubtreeFilter{SubstationClass: PrimarySubstation.String()})
+	assert.Equal(t, []string{"SUBA"}, aliases(subtree))
+
+	subtree, _ = n.GetSubtree("ROOT", 0, SubtreeFilter{Kind: SubtreeLeaves})
+	assert.Equal(t, []string{"SUBA/132_CCT/W1/CB", "SUBA/132_CCT/W2"}, aliases(subtree))
+
+	subtree, _ = n.GetSubtree("ROOT", 0, SubtreeFilter{Kind: SubtreeBranches, AliasGlob: "SUBA/*"})
+	assert.Equal(t, []string{"SUBA/132_CCT/W1"}, aliases(subtree))
+
+	subtree, _ = n.GetSubtree("ROOT", 0, SubtreeFilter{AliasGlob: "*/W?"})
+	assert.Equal(t, []string{"SUBA/132_CCT/W1", "SUBA/132_CCT/W2"}, aliases(subtree))
+
+	// A component whose name can not be generated is returned with the reason
+	addTestComponent(t, n, "5", "SUBA/132_CCT/W3", "W3", "1", 99, PrimaryCircuitID)
+	subtree, _ = n.GetSubtree("SUBA", 1, SubtreeFilter{AliasGlob: "*/W3"})
+	assert.Equal(t, []string{"SUBA/132_CCT/W3"}, aliases(subtree))
+	assert.Empty(t, subtree[0].Name)
+	assert.NotEmpty(t, subtree[0].NameError)
+
+	_, err = n.GetSubtree("ROOT", 0, SubtreeFilter{Kind: "twig"})
+	assert.Error(t, err)
+	_, err = n.GetSubtree("NONE", 0, SubtreeFilter{})
+	assert.Error(t, err)
+}
+
+func TestGetSubtreePage(t *testing.T) {
+	n := newTestNamer(t)
+	addTestComponent(t, n, "4", "SUBA/132_CCT/W2", "W2", "1", 2, PrimaryCircuitID)
+	addTestComponent(t, n, "5", "SUBB", "SUBB", "0", 1, PrimarySubstation)
+	n.ResolveNames()
+
+	var aliases []string
+	next := ""
+	for pages := 0; ; pages++ {
+		page, cursor, err := n.GetSubtreePage("ROOT", 0, SubtreeFilter{}, next, 2)
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(page), 2)
+		for _, comp := range page {
+			aliases = append(aliases, comp.Alias)
+		}
+		if next = cursor; next == "" {
+			assert.Equal(t, 2, pages)
+			break
+		}
+	}
+	assert.Equal(t, []string{"ROOT", "SUBA", "SUBA/132_CCT/W1", "SUBA/132_CCT/W1/CB", "SUBA/132_CCT/W2", "SUBB"}, aliases)
+
+	// The depth limit and filter apply across pages
+	page, next, err := n.GetSubtreePage("SUBA", 1, SubtreeFilter{ComponentClass: "Circuit"}, "", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA/132_CCT/W1", page[0].Alias)
+	page, next, err = n.GetSubtreePage("SUBA", 1, SubtreeFilter{ComponentClass: "Circuit"}, next, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA/132_CCT/W2", page[0].Alias)
+	assert.Equal(t, 1, page[0].Depth)
+	assert.Empty(t, next)
+
+	// Continuing from a component moved out of the subtree fails
+	_, next, _ = n.GetSubtreePage("SUBA", 0, SubtreeFilter{}, "", 2)
+	assert.Equal(t, "SUBA/132_CCT/W1/CB", next)
+	assert.NoError(t, n.MoveComponent("SUBA/132_CCT/W1", "SUBB"))
+	_, _, err = n.GetSubtreePage("SUBA", 0, SubtreeFilter{}, next, 2)
+	assert.ErrorIs(t, err, ErrSubtreeChanged)
+}
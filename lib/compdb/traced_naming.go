@@ -0,0 +1,95 @@
+package compdb
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// TracedRulePrefix is added to NameDetails.RuleName when the name came from a traced name rule
+const TracedRulePrefix = "traced:"
+
+// tracedRuleCandidate is a traced name rule found on a component while tracing from the component being named
+type tracedRuleCandidate struct {
+	source    *Component
+	classDefn *ComponentClassDefn
+	ruleName  string
+}
+
+// SetTracedNaming turns traced naming on or off.
+// When on, components whose class has no name rule are named using the traced name rule
+// of the highest priority component found by tracing from them (see getTracedNameRules).
+// Names are re-resolved if the mode changes and components are loaded.
+func (n *ComponentDb) SetTracedNaming(enabled bool) {
+	if n.tracedNaming == enabled {
+		return
+	}
+	n.tracedNaming = enabled
+	slog.Info("Namer: traced naming", "enabled", enabled)
+	if len(n.componentsByAlias) > 0 {
+		n.ResolveNames()
+	}
+}
+
+// getTracedNameRules traces from the component (parents[0]) to find the traced name rule to use.
+// Connectivity is not loaded so the trace is up the hierarchy, each parent whose class has a
+// TracedNameRule is a candidate if its ApplyToConnCompClasses is empty or lists the class of the component.
+// The candidate with the highest TracedNamingPriority is used, ties go to the nearest parent.
+// The rule evaluated is the source class TracedNamingCompNameRule if set, else its TracedNameRule.
+func (n *ComponentDb) getTracedNameRules(parents []*Component) (string, []*ComponentNameRule, *Component, bool) {
+	if len(parents) < 2 {
+		return "", nil, nil, false
+	}
+
+	origin := parents[0]
+	originClass, err := n.GetComponentClassDefnByIndex(origin.ComponentClass)
+	if err != nil {
+		return "", nil, nil, false
+	}
+
+	var best *tracedRuleCandidate
+	for _, comp := range parents[1:] {
+		classDefn, err := n.GetComponentClassDefnByIndex(comp.ComponentClass)
+		if err != nil || classDefn.TracedNameRule == "" {
+			continue
+		}
+		if !appliesToClass(classDefn.ApplyToConnCompClasses, originClass) {
+			continue
+		}
+		ruleName := classDefn.TracedNamingCompNameRule
+		if ruleName == "" {
+			ruleName = classDefn.TracedNameRule
+		}
+		if best == nil || classDefn.TracedNamingPriority > best.classDefn.TracedNamingPriority {
+			best = &tracedRuleCandidate{source: comp, classDefn: classDefn, ruleName: ruleName}
+		}
+	}
+
+	if best == nil {
+		return "", nil, nil, false
+	}
+
+	rules, ok := n.GetComponentNameRule(best.ruleName)
+	if !ok || len(rules) == 0 {
+		slog.Warn("Traced naming: no name rules found for traced rule", "alias", origin.ComponentAlias, "source", best.source.ComponentAlias, "rule", best.ruleName)
+		return "", nil, nil, false
+	}
+
+	return best.ruleName, rules, best.source, true
+}
+
+// appliesToClass checks the comma separated list of class names (or indexes) from APPLY_TO_CONN_COMP_CLASSES,
+// an empty list applies to all classes
+func appliesToClass(applyToClasses string, classDefn *ComponentClassDefn) bool {
+	if strings.TrimSpace(applyToClasses) == "" {
+		return true
+	}
+	index := fmt.Sprintf("%d", classDefn.ComponentClassIndex)
+	for _, className := range strings.Split(applyToClasses, ",") {
+		className = strings.TrimSpace(className)
+		if className == classDefn.ComponentClassName || className == index {
+			return true
+		}
+	}
+	return false
+}
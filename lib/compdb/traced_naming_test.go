@@ -0,0 +1,43 @@
+package compdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracedNaming(t *testing.T) {
+	n := newTestNamer(t)
+	addTestClass(n, 5, "Feeder Cable", "FC", "")
+	addTestComponent(t, n, "6", "SUBA/132_CCT/W1/FC", "FC1", "2", 5, PrimarySubstationComponent)
+
+	circuitClass := n.classDefByIndex[2]
+	circuitClass.TracedNameRule = "TRACED CCT"
+	circuitClass.TracedNamingPriority = 1
+	circuitClass.ApplyToConnCompClasses = "Feeder Cable, Circuit Breaker"
+	n.nameRules["TRACED CCT"] = []*ComponentNameRule{
+		{NameRule: "TRACED CCT", TextIndex: 1, TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable", UseSeparator: true},
+		{NameRule: "TRACED CCT", TextIndex: 2, TextLocation: NameRuleCircuit, TextType: NameRuleTextTypeAttributeElseName, Data: "Circuit Name", UseSeparator: true},
+		{NameRule: "TRACED CCT", TextIndex: 3, TextLocation: NameRulePlant, TextType: NameRuleTextTypeData, Data: "CABLE", UseSeparator: true},
+	}
+
+	name, err := n.GetName("SUBA/132_CCT/W1/FC")
+	assert.NoError(t, err)
+	assert.Equal(t, "<default>", name.RuleName)
+
+	n.SetTracedNaming(true)
+	full, err := n.GetNameFull("SUBA/132_CCT/W1/FC")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBA, NORTH CABLE", full.Name)
+	assert.Equal(t, TracedRulePrefix+"TRACED CCT", full.RuleName)
+	assert.Equal(t, "SUBA/132_CCT/W1", full.TracedFrom.ComponentAlias)
+
+	comp, _ := n.GetComponent("SUBA/132_CCT/W1/FC")
+	assert.Equal(t, "SUBA, NORTH CABLE", comp.Name)
+
+	// Classes with their own name rule are not traced
+	setTestRules(n, &ComponentNameRule{TextLocation: NameRuleLocation, TextType: NameRuleTextTypeAttributeElseName, Data: "Not Applicable"})
+	full, err = n.GetNameFull("SUBA/132_CCT/W1/CB")
+	assert.NoError(t, err)
+	assert.Nil(t, full.TracedFrom)
+}
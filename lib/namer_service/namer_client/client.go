@@ -3,17 +3,20 @@ package namer_client
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 
 	"github.com/3ideas/psasim/lib/compdb"
 	pb "github.com/3ideas/psasim/lib/namer_service" // Adjust import path as necessary
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type NameClient struct {
-	conn   *grpc.ClientConn
-	client pb.NamerServiceClient
+	conn      *grpc.ClientConn
+	client    pb.NamerServiceClient
+	sessionID string // set by OpenSession, the calls are made in the session
 }
 
 func Connect() (*NameClient, error) {
@@ -34,8 +37,51 @@ func (c *NameClient) Close() {
 	c.conn.Close()
 }
 
+// context returns the context for a call, holding the session ID if a session is open
+func (c *NameClient) context() context.Context {
+	if c.sessionID == "" {
+		return context.Background()
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), pb.SessionMetadataKey, c.sessionID)
+}
+
+// OpenSession opens a session on the server, the changes made by the client are then only seen by the client
+func (c *NameClient) OpenSession() error {
+	response, err := c.client.OpenSession(context.Background(), &pb.OpenSessionRequest{})
+	if err != nil {
+		return fmt.Errorf("could not open session: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not open session: %v", response.Error)
+	}
+	c.sessionID = response.SessionId
+	slog.Info("Opened name server session", "session", c.sessionID)
+	return nil
+}
+
+// UseSession makes the calls in a session that is already open, such as one opened by another client
+func (c *NameClient) UseSession(id string) {
+	c.sessionID = id
+}
+
+// CloseSession closes the session, discarding the changes made in it
+func (c *NameClient) CloseSession() error {
+	if c.sessionID == "" {
+		return fmt.Errorf("could not close session: no session open")
+	}
+	response, err := c.client.CloseSession(context.Background(), &pb.CloseSessionRequest{SessionId: c.sessionID})
+	if err != nil {
+		return fmt.Errorf("could not close session: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not close session: %v", response.Error)
+	}
+	c.sessionID = ""
+	return nil
+}
+
 func (c *NameClient) GetName(alias string) (*compdb.NameDetails, error) {
-	response, err := c.client.GetName(context.Background(), &pb.ComponentAlias{Alias: alias})
+	response, err := c.client.GetName(c.context(), &pb.ComponentAlias{Alias: alias})
 	if err != nil {
 		return nil, fmt.Errorf("could not get name: %v", err)
 	}
@@ -47,7 +93,7 @@ func (c *NameClient) GetName(alias string) (*compdb.NameDetails, error) {
 }
 
 func (c *NameClient) GetHierarchyByAlias(alias string) (compdb.Hierarchy, error) {
-	response, err := c.client.GetHierarchyByAlias(context.Background(), &pb.GetHierarchyByAliasRequest{Alias: alias})
+	response, err := c.client.GetHierarchyByAlias(c.context(), &pb.GetHierarchyByAliasRequest{Alias: alias})
 	if err != nil {
 		return nil, fmt.Errorf("could not get hierarchy: %v", err)
 	}
@@ -60,6 +106,105 @@ func (c *NameClient) GetHierarchyByAlias(alias string) (compdb.Hierarchy, error)
 	return hierarchy, nil
 }
 
+func (c *NameClient) ExplainName(alias string) (*compdb.NameExplanation, error) {
+	response, err := c.client.ExplainName(c.context(), &pb.ComponentAlias{Alias: alias})
+	if err != nil {
+		return nil, fmt.Errorf("could not explain name: %v", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("could not explain name for :%s - %s", alias, response.Error)
+	}
+
+	explanation := &compdb.NameExplanation{Alias: response.Alias, Name: response.Name, RuleName: response.NameRule, Notes: response.Notes}
+	for _, step := range response.Steps {
+		explanation.Steps = append(explanation.Steps, &compdb.NameTraceStep{
+			Step:                 int(step.Step),
+			Part:                 compdb.TextLocationType(step.Part),
+			RuleName:             step.NameRule,
+			TextIndex:            int(step.TextIndex),
+			TextLocation:         compdb.TextLocationType(step.TextLocation),
+			TextType:             compdb.TextTypeType(step.TextType),
+			Data:                 step.Data,
+			Data2:                step.Data2,
+			Component:            step.Component,
+			Forced:               step.Forced,
+			AttributeName:        step.AttributeName,
+			AttributeFound:       step.AttributeFound,
+			Fallback:             step.Fallback,
+			ForceNext:            step.ForceNext,
+			SkippedUseIfNotFound: step.SkippedUseIfNotFound,
+			Value:                step.Value,
+		})
+	}
+	return explanation, nil
+}
+
+func (c *NameClient) FindComponentsByName(name string, fuzzy bool, maxResults int) ([]*compdb.NameMatch, error) {
+	response, err := c.client.FindComponentsByName(c.context(), &pb.FindComponentsByNameRequest{Name: name, Fuzzy: fuzzy, MaxResults: int32(maxResults)})
+	if err != nil {
+		return nil, fmt.Errorf("could not find components by name: %v", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("could not find components by name :%s - %s", name, response.Error)
+	}
+
+	matches := make([]*compdb.NameMatch, 0, len(response.Matches))
+	for _, match := range response.Matches {
+		matches = append(matches, &compdb.NameMatch{Alias: match.Alias, Name: match.Name, Score: match.Score, Exact: match.Exact})
+	}
+	return matches, nil
+}
+
+func (c *NameClient) ChangeScript() ([]string, error) {
+	response, err := c.client.GetChangeScript(c.context(), &pb.GetChangeScriptRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get change script: %v", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("could not get change script: %s", response.Error)
+	}
+	return response.Lines, nil
+}
+
+func (c *NameClient) PreviewChanges(changes []compdb.Change) ([]*compdb.NameImpact, error) {
+	request := &pb.PreviewChangesRequest{}
+	for _, change := range changes {
+		request.Changes = append(request.Changes, &pb.Change{
+			Action:              string(change.Action),
+			Alias:               change.Alias,
+			Name:                change.Name,
+			ParentAlias:         change.ParentAlias,
+			TemplateAlias:       change.TemplateAlias,
+			SubstationClassName: change.SubstationClassName,
+			AttrName:            change.AttributeName,
+			AttrValue:           change.AttributeValue,
+			Recursive:           change.Recursive,
+		})
+	}
+
+	response, err := c.client.PreviewChanges(c.context(), request)
+	if err != nil {
+		return nil, fmt.Errorf("could not preview changes: %v", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("could not preview changes: %s", response.Error)
+	}
+
+	impacts := make([]*compdb.NameImpact, 0, len(response.Impacts))
+	for _, impact := range response.Impacts {
+		impacts = append(impacts, &compdb.NameImpact{
+			Alias:   impact.Alias,
+			OldName: impact.OldName,
+			NewName: impact.NewName,
+			OldPath: impact.OldPath,
+			NewPath: impact.NewPath,
+			Created: impact.Created,
+			Deleted: impact.Deleted,
+		})
+	}
+	return impacts, nil
+}
+
 func (c *NameClient) convertNameResponse(name *pb.GetNameResponse) *compdb.NameDetails {
 	return &compdb.NameDetails{
 		Name:     name.Name,
@@ -74,7 +219,7 @@ func (c *NameClient) convertNameResponse(name *pb.GetNameResponse) *compdb.NameD
 }
 
 func (c *NameClient) GetNameWithHierarchy(alias string) (*compdb.NameWithHierachy, error) {
-	response, err := c.client.GetNameWithHierarchy(context.Background(), &pb.ComponentAlias{Alias: alias})
+	response, err := c.client.GetNameWithHierarchy(c.context(), &pb.ComponentAlias{Alias: alias})
 	if err != nil {
 		return nil, fmt.Errorf("could not get name with hierarchy: %v", err)
 	}
@@ -109,13 +254,23 @@ func (c *NameClient) convertComponentInfo(comp *pb.ComponentInfo) *compdb.Compon
 func (c *NameClient) convertNamePartResponse(namePart *pb.NamePartResponse) *compdb.NamePartResponse {
 	namePartDetails := []*compdb.NamePartDetailsResponse{}
 	for _, detail := range namePart.NamePartDetails {
-		namePartDetails = append(namePartDetails, &compdb.NamePartDetailsResponse{Value: detail.Value, Source: detail.Source, RawValue: detail.RawValue, Separator: detail.Separator})
+		namePartDetails = append(namePartDetails, &compdb.NamePartDetailsResponse{
+			Value:     detail.Value,
+			Source:    detail.Source,
+			RawValue:  detail.RawValue,
+			Separator: detail.Separator,
+			PreText:   detail.PreText,
+			PostText:  detail.PostText,
+			JoinText:  detail.JoinText,
+			Data2Used: detail.Data2Used,
+			TextError: detail.TextError,
+		})
 	}
 	return &compdb.NamePartResponse{Value: namePart.Value, Used: namePart.Used, Alias: namePart.Alias, Details: namePartDetails}
 }
 
 func (c *NameClient) RenameComponent(alias, newName string) error {
-	response, err := c.client.RenameComponent(context.Background(), &pb.RenameComponentRequest{Alias: alias, NewName: newName})
+	response, err := c.client.RenameComponent(c.context(), &pb.RenameComponentRequest{Alias: alias, NewName: newName})
 	if err != nil {
 		return fmt.Errorf("could not rename: %v", err)
 	}
@@ -126,7 +281,7 @@ func (c *NameClient) RenameComponent(alias, newName string) error {
 }
 
 func (c *NameClient) MoveComponent(alias, newLocationAlias string) error {
-	response, err := c.client.MoveComponent(context.Background(), &pb.MoveComponentRequest{Alias: alias, NewLocationAlias: newLocationAlias})
+	response, err := c.client.MoveComponent(c.context(), &pb.MoveComponentRequest{Alias: alias, NewLocationAlias: newLocationAlias})
 	if err != nil {
 		return fmt.Errorf("could not move: %v", err)
 	}
@@ -137,7 +292,7 @@ func (c *NameClient) MoveComponent(alias, newLocationAlias string) error {
 }
 
 func (c *NameClient) CreateAttribute(alias, attrName, attrValue string) error {
-	response, err := c.client.CreateAttribute(context.Background(), &pb.CreateAttributeRequest{Alias: alias, AttrName: attrName, AttrValue: attrValue})
+	response, err := c.client.CreateAttribute(c.context(), &pb.CreateAttributeRequest{Alias: alias, AttrName: attrName, AttrValue: attrValue})
 	if err != nil {
 		return fmt.Errorf("could not create attribute: %v", err)
 	}
@@ -148,7 +303,7 @@ func (c *NameClient) CreateAttribute(alias, attrName, attrValue string) error {
 }
 
 func (c *NameClient) UpdateAttribute(alias, attrName, attrValue string) error {
-	response, err := c.client.UpdateAttribute(context.Background(), &pb.UpdateAttributeRequest{Alias: alias, AttrName: attrName, AttrValue: attrValue})
+	response, err := c.client.UpdateAttribute(c.context(), &pb.UpdateAttributeRequest{Alias: alias, AttrName: attrName, AttrValue: attrValue})
 	if err != nil {
 		return fmt.Errorf("could not update attribute: %v", err)
 	}
@@ -159,7 +314,7 @@ func (c *NameClient) UpdateAttribute(alias, attrName, attrValue string) error {
 }
 
 func (c *NameClient) CreateComponent(alias, name, parentAlias, templateAlias, substationClassName string) error {
-	response, err := c.client.CreateComponent(context.Background(), &pb.CreateComponentRequest{
+	response, err := c.client.CreateComponent(c.context(), &pb.CreateComponentRequest{
 		Alias:               alias,
 		Name:                name,
 		ParentAlias:         parentAlias,
@@ -175,8 +330,36 @@ func (c *NameClient) CreateComponent(alias, name, parentAlias, templateAlias, su
 	return nil
 }
 
+func (c *NameClient) DeleteComponent(alias string, recursive bool) error {
+	response, err := c.client.DeleteComponent(c.context(), &pb.DeleteComponentRequest{
+		Alias:     alias,
+		Recursive: recursive,
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete component: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not delete component: %v", response.Error)
+	}
+	return nil
+}
+
+func (c *NameClient) DeleteAttribute(alias, attrName string) error {
+	response, err := c.client.DeleteAttribute(c.context(), &pb.DeleteAttributeRequest{
+		Alias:    alias,
+		AttrName: attrName,
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete attribute: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not delete attribute: %v", response.Error)
+	}
+	return nil
+}
+
 func (c *NameClient) RollbackAll() error {
-	response, err := c.client.RollbackAll(context.Background(), &pb.RollbackAllRequest{})
+	response, err := c.client.RollbackAll(c.context(), &pb.RollbackAllRequest{})
 	if err != nil {
 		return fmt.Errorf("could not rollback all: %v", err)
 	}
@@ -187,7 +370,7 @@ func (c *NameClient) RollbackAll() error {
 }
 
 func (c *NameClient) GetNumberOfChanges() (int, error) {
-	response, err := c.client.GetNumberOfChanges(context.Background(), &pb.GetNumberOfChangesRequest{})
+	response, err := c.client.GetNumberOfChanges(c.context(), &pb.GetNumberOfChangesRequest{})
 	if err != nil {
 		return 0, fmt.Errorf("could not get number of changes: %v", err)
 	}
@@ -196,7 +379,7 @@ func (c *NameClient) GetNumberOfChanges() (int, error) {
 
 func (c *NameClient) SetRollbackPoint() error {
 	slog.Info("Setting rollback point")
-	response, err := c.client.SetRollbackPoint(context.Background(), &pb.SetRollbackPointRequest{})
+	response, err := c.client.SetRollbackPoint(c.context(), &pb.SetRollbackPointRequest{})
 	if err != nil {
 		return fmt.Errorf("could not set rollback point: %v", err)
 	}
@@ -208,7 +391,7 @@ func (c *NameClient) SetRollbackPoint() error {
 
 func (c *NameClient) RollbackToPoint() error {
 	slog.Info("Rolling back to point")
-	response, err := c.client.RollbackToPoint(context.Background(), &pb.RollbackToPointRequest{})
+	response, err := c.client.RollbackToPoint(c.context(), &pb.RollbackToPointRequest{})
 	if err != nil {
 		return fmt.Errorf("could not rollback to point: %v", err)
 	}
@@ -218,8 +401,67 @@ func (c *NameClient) RollbackToPoint() error {
 	return nil
 }
 
+func (c *NameClient) SetSavepoint(name string) error {
+	response, err := c.client.SetSavepoint(c.context(), &pb.SetSavepointRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("could not set savepoint: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not set savepoint: %v", response.Error)
+	}
+	return nil
+}
+
+func (c *NameClient) RollbackToSavepoint(name string) error {
+	response, err := c.client.RollbackToSavepoint(c.context(), &pb.RollbackToSavepointRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("could not rollback to savepoint: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not rollback to savepoint: %v", response.Error)
+	}
+	return nil
+}
+
+func (c *NameClient) ReleaseSavepoint(name string) error {
+	response, err := c.client.ReleaseSavepoint(c.context(), &pb.ReleaseSavepointRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("could not release savepoint: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not release savepoint: %v", response.Error)
+	}
+	return nil
+}
+
+func (c *NameClient) Savepoints() ([]compdb.Savepoint, error) {
+	response, err := c.client.GetSavepoints(c.context(), &pb.GetSavepointsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get savepoints: %v", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("could not get savepoints: %v", response.Error)
+	}
+	savepoints := make([]compdb.Savepoint, 0, len(response.Savepoints))
+	for _, savepoint := range response.Savepoints {
+		savepoints = append(savepoints, compdb.Savepoint{Name: savepoint.Name, Changes: int(savepoint.Changes)})
+	}
+	return savepoints, nil
+}
+
+func (c *NameClient) Redo() error {
+	response, err := c.client.Redo(c.context(), &pb.RedoRequest{})
+	if err != nil {
+		return fmt.Errorf("could not redo: %v", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("could not redo: %v", response.Error)
+	}
+	return nil
+}
+
 func (c *NameClient) GetAttributeValue(alias, attrName string) (compdb.AttributeValue, error) {
-	response, err := c.client.GetAttributeValue(context.Background(), &pb.GetAttributeValueRequest{Alias: alias, AttrName: attrName})
+	response, err := c.client.GetAttributeValue(c.context(), &pb.GetAttributeValueRequest{Alias: alias, AttrName: attrName})
 	if err != nil {
 		return compdb.AttributeValue{}, fmt.Errorf("could not get attribute: %v", err)
 	}
@@ -236,7 +478,7 @@ func (c *NameClient) GetAttributeValue(alias, attrName string) (compdb.Attribute
 }
 
 func (c *NameClient) GetComponentClassDetails(alias string) (*compdb.ComponentClassDetails, error) {
-	response, err := c.client.GetComponentClass(context.Background(), &pb.GetComponentClassRequest{Alias: alias})
+	response, err := c.client.GetComponentClass(c.context(), &pb.GetComponentClassRequest{Alias: alias})
 	if err != nil {
 		return nil, fmt.Errorf("could not get component class: %v", err)
 	}
@@ -247,7 +489,7 @@ func (c *NameClient) GetComponentClassDetails(alias string) (*compdb.ComponentCl
 }
 
 func (c *NameClient) GetComponentInfoByID(id string) (*compdb.ComponentInfo, error) {
-	response, err := c.client.GetComponentByID(context.Background(), &pb.ComponentID{ComponentID: id})
+	response, err := c.client.GetComponentByID(c.context(), &pb.ComponentID{ComponentID: id})
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +500,7 @@ func (c *NameClient) GetComponentInfoByID(id string) (*compdb.ComponentInfo, err
 }
 
 func (c *NameClient) GetChildrenInfoByID(id string) ([]*compdb.ComponentInfo, error) {
-	response, err := c.client.GetChildrenInfoByID(context.Background(), &pb.ComponentID{ComponentID: id})
+	response, err := c.client.GetChildrenInfoByID(c.context(), &pb.ComponentID{ComponentID: id})
 	if err != nil {
 		return nil, err
 	}
@@ -273,8 +515,58 @@ func (c *NameClient) GetChildrenInfoByID(id string) ([]*compdb.ComponentInfo, er
 	return children, nil
 }
 
+// GetSubtree returns the components of the subtree that match the filter, see compdb.ComponentDb.GetSubtree
+func (c *NameClient) GetSubtree(alias string, maxDepth int, filter compdb.SubtreeFilter) ([]*compdb.SubtreeComponent, error) {
+	stream, err := c.client.GetSubtree(c.context(), &pb.GetSubtreeRequest{
+		Alias:           alias,
+		MaxDepth:        int32(maxDepth),
+		ComponentClass:  filter.ComponentClass,
+		SubstationClass: filter.SubstationClass,
+		Kind:            string(filter.Kind),
+		AliasGlob:       filter.AliasGlob,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get subtree: %v", err)
+	}
+	subtree := []*compdb.SubtreeComponent{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return subtree, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get subtree: %v", err)
+		}
+		if response.Error != "" {
+			return nil, fmt.Errorf("could not get subtree: %v", response.Error)
+		}
+		subtree = append(subtree, &compdb.SubtreeComponent{
+			ComponentInfo: *c.convertComponentInfo(response.Component.CompInfo),
+			Name:          response.Component.Name,
+			NameError:     response.Component.NameError,
+			Depth:         int(response.Component.Depth),
+			ParentAlias:   response.Component.ParentAlias,
+		})
+	}
+}
+
+func (c *NameClient) Query(query string) (*compdb.QueryResult, error) {
+	response, err := c.client.Query(c.context(), &pb.QueryRequest{Query: query})
+	if err != nil {
+		return nil, fmt.Errorf("could not run query: %v", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("could not run query: %v", response.Error)
+	}
+	result := &compdb.QueryResult{Columns: response.Columns, Rows: make([][]string, 0, len(response.Rows))}
+	for _, row := range response.Rows {
+		result.Rows = append(result.Rows, row.Values)
+	}
+	return result, nil
+}
+
 func (c *NameClient) GetComponentInfo(alias string) (*compdb.ComponentInfo, error) {
-	response, err := c.client.GetComponentInfo(context.Background(), &pb.ComponentAlias{Alias: alias})
+	response, err := c.client.GetComponentInfo(c.context(), &pb.ComponentAlias{Alias: alias})
 	if response.Error != "" {
 		return nil, fmt.Errorf("could not get component info: %v", response.Error)
 	}
@@ -3,28 +3,30 @@ package namer_server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux" // Make sure to import the gorilla/mux package
 
 	"github.com/3ideas/psasim/lib/compdb"
 	pb "github.com/3ideas/psasim/lib/namer_service" // Adjust import path as necessary
-
-	"google.golang.org/grpc"
 )
 
 type server struct {
 	pb.UnimplementedNamerServiceServer
-	namer *compdb.ComponentDb
+	namer          *compdb.ComponentDb
+	sessionTimeout time.Duration
 }
 
 func NewNameServer(namer *compdb.ComponentDb) *server {
 	return &server{
-		namer: namer,
+		namer:          namer,
+		sessionTimeout: DefaultSessionTimeout,
 	}
 }
 
@@ -41,9 +43,11 @@ func (s *server) GetName(ctx context.Context, req *pb.ComponentAlias) (*pb.GetNa
 
 	nameResponse := convertNameDetails(&nameDetails.NameDetails)
 
+	// The parents are the live components, other clients can rename or move them once GetNameFull returns,
+	// so only the alias and ID, which do not change, are logged
 	for i := len(nameDetails.Parents) - 1; i >= 0; i-- {
 		comp := nameDetails.Parents[i]
-		slog.Debug("Parents", "alias", comp.ComponentAlias, "name", comp.ComponentPathname, "SubstationClass", comp.ComponentSubstationClass, "Class", comp.ComponentClass, "Parent", comp.ComponentParentID, "ID", comp.ComponentID)
+		slog.Debug("Parents", "alias", comp.ComponentAlias, "ID", comp.ComponentID)
 	}
 
 	return nameResponse, nil
@@ -57,6 +61,119 @@ func (s *server) GetHierarchyByAlias(ctx context.Context, req *pb.GetHierarchyBy
 	return &pb.GetHierarchyByAliasResponse{Hierarchy: convertComponentInfoList(hierarchy)}, nil
 }
 
+func (s *server) ExplainName(ctx context.Context, req *pb.ComponentAlias) (*pb.ExplainNameResponse, error) {
+	explanation, err := s.namer.ExplainName(req.Alias)
+	if err != nil {
+		slog.Warn("Failed to explain name", "alias", req.Alias, "error", err)
+		return &pb.ExplainNameResponse{Error: err.Error()}, nil
+	}
+
+	steps := []*pb.NameTraceStep{}
+	for _, step := range explanation.Steps {
+		steps = append(steps, &pb.NameTraceStep{
+			Step:                 int32(step.Step),
+			Part:                 int32(step.Part),
+			NameRule:             step.RuleName,
+			TextIndex:            int32(step.TextIndex),
+			TextLocation:         int32(step.TextLocation),
+			TextType:             int32(step.TextType),
+			Data:                 step.Data,
+			Data2:                step.Data2,
+			Component:            step.Component,
+			Forced:               step.Forced,
+			AttributeName:        step.AttributeName,
+			AttributeFound:       step.AttributeFound,
+			Fallback:             step.Fallback,
+			ForceNext:            step.ForceNext,
+			SkippedUseIfNotFound: step.SkippedUseIfNotFound,
+			Value:                step.Value,
+		})
+	}
+
+	return &pb.ExplainNameResponse{
+		Alias:    explanation.Alias,
+		Name:     explanation.Name,
+		NameRule: explanation.RuleName,
+		Steps:    steps,
+		Notes:    explanation.Notes,
+	}, nil
+}
+
+func (s *server) FindComponentsByName(ctx context.Context, req *pb.FindComponentsByNameRequest) (*pb.FindComponentsByNameResponse, error) {
+	matches, err := s.namer.FindComponentsByName(req.Name, req.Fuzzy, int(req.MaxResults))
+	if err != nil {
+		slog.Warn("Failed to find components by name", "name", req.Name, "error", err)
+		return &pb.FindComponentsByNameResponse{Error: err.Error()}, nil
+	}
+
+	response := &pb.FindComponentsByNameResponse{}
+	for _, match := range matches {
+		response.Matches = append(response.Matches, &pb.NameMatch{
+			Alias: match.Alias,
+			Name:  match.Name,
+			Score: match.Score,
+			Exact: match.Exact,
+		})
+	}
+	return response, nil
+}
+
+// FindComponentsByNameJSON handles the JSON request for FindComponentsByName
+func (s *server) FindComponentsByNameJSON(w http.ResponseWriter, r *http.Request) {
+	var req pb.FindComponentsByNameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.FindComponentsByName(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	jsonResponse, _ := json.MarshalIndent(resp, "", "  ") // Indent with 2 spaces
+	w.Write(jsonResponse)
+}
+
+func (s *server) PreviewChanges(ctx context.Context, req *pb.PreviewChangesRequest) (*pb.PreviewChangesResponse, error) {
+	changes := []compdb.Change{}
+	for _, change := range req.Changes {
+		changes = append(changes, compdb.Change{
+			Action:              compdb.RollbackAction(change.Action),
+			Alias:               change.Alias,
+			Name:                change.Name,
+			ParentAlias:         change.ParentAlias,
+			TemplateAlias:       change.TemplateAlias,
+			SubstationClassName: change.SubstationClassName,
+			AttributeName:       change.AttrName,
+			AttributeValue:      change.AttrValue,
+			Recursive:           change.Recursive,
+		})
+	}
+
+	impacts, err := s.namer.PreviewChanges(changes)
+	if err != nil {
+		slog.Warn("Failed to preview changes", "changes", len(changes), "error", err)
+		return &pb.PreviewChangesResponse{Error: err.Error()}, nil
+	}
+
+	response := &pb.PreviewChangesResponse{}
+	for _, impact := range impacts {
+		response.Impacts = append(response.Impacts, &pb.NameImpact{
+			Alias:   impact.Alias,
+			OldName: impact.OldName,
+			NewName: impact.NewName,
+			OldPath: impact.OldPath,
+			NewPath: impact.NewPath,
+			Created: impact.Created,
+			Deleted: impact.Deleted,
+		})
+	}
+	return response, nil
+}
+
 func convertNameDetails(nameDetails *compdb.NameDetails) *pb.GetNameResponse {
 	location := convertNamePartDetails(nameDetails.Location)
 	circuit := convertNamePartDetails(nameDetails.Circuit)
@@ -107,7 +224,17 @@ func convertNamePartDetails(part *compdb.NamePartResponse) *pb.NamePartResponse
 }
 
 func convertNamePartDetailsResponse(detail *compdb.NamePartDetailsResponse) *pb.NamePartDetailResponse {
-	return &pb.NamePartDetailResponse{Value: detail.Value, Source: detail.Source, RawValue: detail.RawValue, Separator: detail.Separator}
+	return &pb.NamePartDetailResponse{
+		Value:     detail.Value,
+		Source:    detail.Source,
+		RawValue:  detail.RawValue,
+		Separator: detail.Separator,
+		PreText:   detail.PreText,
+		PostText:  detail.PostText,
+		JoinText:  detail.JoinText,
+		Data2Used: detail.Data2Used,
+		TextError: detail.TextError,
+	}
 }
 
 // Rename method implementation
@@ -155,6 +282,24 @@ func (s *server) CreateComponent(ctx context.Context, req *pb.CreateComponentReq
 	return &pb.CreateComponentResponse{Error: ""}, nil
 }
 
+// DeleteComponent method implementation
+func (s *server) DeleteComponent(ctx context.Context, req *pb.DeleteComponentRequest) (*pb.DeleteComponentResponse, error) {
+	err := s.namer.DeleteComponent(req.Alias, req.Recursive)
+	if err != nil {
+		return &pb.DeleteComponentResponse{Error: err.Error()}, nil
+	}
+	return &pb.DeleteComponentResponse{Error: ""}, nil
+}
+
+// DeleteAttribute method implementation
+func (s *server) DeleteAttribute(ctx context.Context, req *pb.DeleteAttributeRequest) (*pb.DeleteAttributeResponse, error) {
+	err := s.namer.DeleteAttribute(req.Alias, req.AttrName)
+	if err != nil {
+		return &pb.DeleteAttributeResponse{Error: err.Error()}, nil
+	}
+	return &pb.DeleteAttributeResponse{Error: ""}, nil
+}
+
 // RollbackAll method implementation
 func (s *server) RollbackAll(ctx context.Context, req *pb.RollbackAllRequest) (*pb.RollbackAllResponse, error) {
 	err := s.namer.RollbackAll()
@@ -170,6 +315,15 @@ func (s *server) GetNumberOfChanges(ctx context.Context, req *pb.GetNumberOfChan
 	return &pb.GetNumberOfChangesResponse{NumberOfChanges: int32(numChanges)}, nil
 }
 
+func (s *server) GetChangeScript(ctx context.Context, req *pb.GetChangeScriptRequest) (*pb.GetChangeScriptResponse, error) {
+	lines, err := s.namer.ChangeScript()
+	if err != nil {
+		slog.Warn("Failed to build change script", "error", err)
+		return &pb.GetChangeScriptResponse{Error: err.Error()}, nil
+	}
+	return &pb.GetChangeScriptResponse{Lines: lines}, nil
+}
+
 // Add this function to handle the JSON request
 func (s *server) GetNameJSON(w http.ResponseWriter, r *http.Request) {
 	var req pb.ComponentAlias
@@ -244,6 +398,137 @@ func (s *server) RollbackToPoint(ctx context.Context, req *pb.RollbackToPointReq
 	return &pb.RollbackToPointResponse{Error: ""}, nil
 }
 
+// SetSavepoint method implementation
+func (s *server) SetSavepoint(ctx context.Context, req *pb.SetSavepointRequest) (*pb.SetSavepointResponse, error) {
+	err := s.namer.SetSavepoint(req.Name)
+	if err != nil {
+		return &pb.SetSavepointResponse{Error: err.Error()}, nil
+	}
+	return &pb.SetSavepointResponse{Error: ""}, nil
+}
+
+// RollbackToSavepoint method implementation
+func (s *server) RollbackToSavepoint(ctx context.Context, req *pb.RollbackToSavepointRequest) (*pb.RollbackToSavepointResponse, error) {
+	err := s.namer.RollbackToSavepoint(req.Name)
+	if err != nil {
+		return &pb.RollbackToSavepointResponse{Error: err.Error()}, nil
+	}
+	return &pb.RollbackToSavepointResponse{Error: ""}, nil
+}
+
+// ReleaseSavepoint method implementation
+func (s *server) ReleaseSavepoint(ctx context.Context, req *pb.ReleaseSavepointRequest) (*pb.ReleaseSavepointResponse, error) {
+	err := s.namer.ReleaseSavepoint(req.Name)
+	if err != nil {
+		return &pb.ReleaseSavepointResponse{Error: err.Error()}, nil
+	}
+	return &pb.ReleaseSavepointResponse{Error: ""}, nil
+}
+
+// GetSavepoints method implementation
+func (s *server) GetSavepoints(ctx context.Context, req *pb.GetSavepointsRequest) (*pb.GetSavepointsResponse, error) {
+	savepoints, err := s.namer.Savepoints()
+	if err != nil {
+		return &pb.GetSavepointsResponse{Error: err.Error()}, nil
+	}
+	response := &pb.GetSavepointsResponse{}
+	for _, savepoint := range savepoints {
+		response.Savepoints = append(response.Savepoints, &pb.Savepoint{Name: savepoint.Name, Changes: int32(savepoint.Changes)})
+	}
+	return response, nil
+}
+
+// Redo method implementation
+func (s *server) Redo(ctx context.Context, req *pb.RedoRequest) (*pb.RedoResponse, error) {
+	err := s.namer.Redo()
+	if err != nil {
+		return &pb.RedoResponse{Error: err.Error()}, nil
+	}
+	return &pb.RedoResponse{Error: ""}, nil
+}
+
+// subtreeBatchSize is the most components GetSubtree reads before sending them
+var subtreeBatchSize = 1000
+
+// GetSubtree method implementation. The subtree is read a batch at a time in the call's session, each batch is
+// sent before the next is read, so a slow client does not hold up other sessions and a large subtree is not
+// held in memory; streams are not run in a session by the interceptor.
+func (s *server) GetSubtree(req *pb.GetSubtreeRequest, stream pb.NamerService_GetSubtreeServer) error {
+	filter := compdb.SubtreeFilter{
+		ComponentClass:  req.ComponentClass,
+		SubstationClass: req.SubstationClass,
+		Kind:            compdb.SubtreeKind(req.Kind),
+		AliasGlob:       req.AliasGlob,
+	}
+	next := ""
+	for {
+		var page []*compdb.SubtreeComponent
+		err := s.namer.WithSession(sessionID(stream.Context()), func() error {
+			var err error
+			page, next, err = s.namer.GetSubtreePage(req.Alias, int(req.MaxDepth), filter, next, subtreeBatchSize)
+			return err
+		})
+		if errors.Is(err, compdb.ErrUnknownSession) || errors.Is(err, compdb.ErrSessionConflict) {
+			return sessionStatus(err)
+		}
+		if err != nil {
+			return stream.Send(&pb.GetSubtreeResponse{Error: err.Error()})
+		}
+		for _, comp := range page {
+			if err := stream.Send(&pb.GetSubtreeResponse{Component: &pb.SubtreeComponent{
+				CompInfo:    convertComponentInfo(&comp.ComponentInfo),
+				Name:        comp.Name,
+				NameError:   comp.NameError,
+				Depth:       int32(comp.Depth),
+				ParentAlias: comp.ParentAlias,
+			}}); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+	}
+}
+
+// Query method implementation
+func (s *server) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResponse, error) {
+	result, err := s.namer.Query(req.Query)
+	if err != nil {
+		return &pb.QueryResponse{Error: err.Error()}, nil
+	}
+	response := &pb.QueryResponse{Columns: result.Columns, Rows: make([]*pb.QueryRow, 0, len(result.Rows))}
+	for _, row := range result.Rows {
+		response.Rows = append(response.Rows, &pb.QueryRow{Values: row})
+	}
+	if req.Format != "" {
+		output, err := result.Format(req.Format)
+		if err != nil {
+			return &pb.QueryResponse{Error: err.Error()}, nil
+		}
+		response.Output = string(output)
+	}
+	return response, nil
+}
+
+// OpenSession method implementation
+func (s *server) OpenSession(ctx context.Context, req *pb.OpenSessionRequest) (*pb.OpenSessionResponse, error) {
+	id, err := s.namer.OpenSession()
+	if err != nil {
+		return &pb.OpenSessionResponse{Error: err.Error()}, nil
+	}
+	return &pb.OpenSessionResponse{SessionId: id, Error: ""}, nil
+}
+
+// CloseSession method implementation
+func (s *server) CloseSession(ctx context.Context, req *pb.CloseSessionRequest) (*pb.CloseSessionResponse, error) {
+	err := s.namer.CloseSession(req.SessionId)
+	if err != nil {
+		return &pb.CloseSessionResponse{Error: err.Error()}, nil
+	}
+	return &pb.CloseSessionResponse{Error: ""}, nil
+}
+
 func (s *server) GetComponentInfoByID(ctx context.Context, req *pb.ComponentID) (*pb.ComponentInfoResponse, error) {
 	compInfo, err := s.namer.GetComponentInfoByID(req.ComponentID)
 	if err != nil {
@@ -315,13 +600,14 @@ func (s *server) StartServer() error {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterNamerServiceServer(grpcServer, s)
+	grpcServer := s.newGRPCServer()
+	go s.expireSessions()
 
 	// Set up the HTTP server
 	router := mux.NewRouter()
-	router.HandleFunc("/getname", s.GetNameJSON).Methods("POST")
-	router.HandleFunc("/getattributevalue", s.GetAttributeValueJSON).Methods("POST") // Add this line
+	router.HandleFunc("/getname", s.inSession(s.GetNameJSON)).Methods("POST")
+	router.HandleFunc("/getattributevalue", s.inSession(s.GetAttributeValueJSON)).Methods("POST") // Add this line
+	router.HandleFunc("/findbyname", s.inSession(s.FindComponentsByNameJSON)).Methods("POST")
 
 	go func() {
 		if err := http.ListenAndServe(":50052", router); err != nil {
@@ -0,0 +1,310 @@
+package namer_server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/3ideas/psasim/lib/compdb"
+	pb "github.com/3ideas/psasim/lib/namer_service"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	testSubstations = 8
+	testIterations  = 25
+)
+
+// startTestServer serves a small network, ROOT with SUB0..SUB7 each holding a CB, over an in memory
+// connection. The network is built with CreateComponent, so the changes it returns are the ones made
+// building it.
+func startTestServer(t *testing.T) (pb.NamerServiceClient, int) {
+	t.Helper()
+	namer := compdb.NewCompDb()
+	assert.NoError(t, namer.CreateComponent("ROOT", "ROOT", "", "", ""))
+	for i := 0; i < testSubstations; i++ {
+		sub := fmt.Sprintf("SUB%d", i)
+		assert.NoError(t, namer.CreateComponent(sub, sub, "ROOT", "", "Primary Substation"))
+		assert.NoError(t, namer.CreateComponent(sub+"/CB", "CB", sub, "", "Primary Substation Component"))
+	}
+	changes, _ := namer.GetNumberOfChanges()
+	assert.NoError(t, namer.SetSavepoint("loaded"))
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := NewNameServer(namer).newGRPCServer()
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("connecting to test server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewNamerServiceClient(conn), changes
+}
+
+func getPath(t *testing.T, client pb.NamerServiceClient, alias string) string {
+	response, err := client.GetComponentInfo(context.Background(), &pb.ComponentAlias{Alias: alias})
+	if !assert.NoError(t, err) || !assert.Empty(t, response.Error) {
+		return ""
+	}
+	return response.CompInfo.Path
+}
+
+// TestParallelClients runs writers, readers and previews at the same time, run with -race
+func TestParallelClients(t *testing.T) {
+	client, loaded := startTestServer(t)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+
+	// Each writer renames its own CB back and forth and sets an attribute on its substation
+	for i := 0; i < testSubstations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cb := fmt.Sprintf("SUB%d/CB", i)
+			for j := 0; j < testIterations; j++ {
+				response, err := client.RenameComponent(ctx, &pb.RenameComponentRequest{Alias: cb, NewName: fmt.Sprintf("CB%d", j)})
+				assert.NoError(t, err)
+				assert.Empty(t, response.Error)
+				attrResponse, err := client.CreateAttribute(ctx, &pb.CreateAttributeRequest{Alias: fmt.Sprintf("SUB%d", i), AttrName: "Plant", AttrValue: fmt.Sprint(j)})
+				assert.NoError(t, err)
+				assert.Empty(t, attrResponse.Error)
+			}
+		}(i)
+	}
+
+	// Previews rename SUB0 and roll it back, readers must never see the previewed name
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < testIterations; j++ {
+			response, err := client.PreviewChanges(ctx, &pb.PreviewChangesRequest{Changes: []*pb.Change{
+				{Action: string(compdb.RenameComponentAction), Alias: "SUB0", Name: "PREVIEW"},
+			}})
+			assert.NoError(t, err)
+			assert.Empty(t, response.Error)
+		}
+	}()
+
+	for i := 0; i < testSubstations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < testIterations; j++ {
+				assert.Equal(t, "SUB0", getPath(t, client, "SUB0"))
+				getPath(t, client, fmt.Sprintf("SUB%d/CB", i))
+				_, err := client.GetAttributeValue(ctx, &pb.GetAttributeValueRequest{Alias: fmt.Sprintf("SUB%d", i), AttrName: "Plant"})
+				assert.NoError(t, err)
+				_, err = client.GetName(ctx, &pb.ComponentAlias{Alias: fmt.Sprintf("SUB%d/CB", i)}) // no class definitions, so no name
+				assert.NoError(t, err)
+				_, err = client.GetHierarchyByAlias(ctx, &pb.GetHierarchyByAliasRequest{Alias: fmt.Sprintf("SUB%d/CB", i)})
+				assert.NoError(t, err)
+				_, err = client.FindComponentsByName(ctx, &pb.FindComponentsByNameRequest{Name: "SUB", Fuzzy: true})
+				assert.NoError(t, err)
+				_, err = client.GetChangeScript(ctx, &pb.GetChangeScriptRequest{})
+				assert.NoError(t, err)
+				_, err = client.GetNumberOfChanges(ctx, &pb.GetNumberOfChangesRequest{})
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	changes, err := client.GetNumberOfChanges(ctx, &pb.GetNumberOfChangesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(loaded+2*testSubstations*testIterations), changes.NumberOfChanges)
+	for i := 0; i < testSubstations; i++ {
+		assert.Equal(t, fmt.Sprintf("CB%d", testIterations-1), getPath(t, client, fmt.Sprintf("SUB%d/CB", i)))
+	}
+}
+
+// TestParallelRollbacks rolls back while other clients make changes, the savepoint restores every component
+func TestParallelRollbacks(t *testing.T) {
+	client, loaded := startTestServer(t)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+
+	for i := 0; i < testSubstations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cb := fmt.Sprintf("SUB%d/CB", i)
+			for j := 0; j < testIterations; j++ {
+				response, err := client.MoveComponent(ctx, &pb.MoveComponentRequest{Alias: cb, NewLocationAlias: "ROOT"})
+				assert.NoError(t, err)
+				assert.Empty(t, response.Error)
+				switch j % 5 {
+				case 0:
+					_, err = client.RollbackToPoint(ctx, &pb.RollbackToPointRequest{}) // back to the loaded savepoint
+					assert.NoError(t, err)
+				case 1:
+					_, err = client.Redo(ctx, &pb.RedoRequest{}) // fails if another client made a change since
+					assert.NoError(t, err)
+				}
+				getPath(t, client, cb)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	response, err := client.RollbackToSavepoint(ctx, &pb.RollbackToSavepointRequest{Name: "loaded"})
+	assert.NoError(t, err)
+	assert.Empty(t, response.Error)
+	changes, err := client.GetNumberOfChanges(ctx, &pb.GetNumberOfChangesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(loaded), changes.NumberOfChanges)
+	for i := 0; i < testSubstations; i++ {
+		hierarchy, err := client.GetHierarchyByAlias(ctx, &pb.GetHierarchyByAliasRequest{Alias: fmt.Sprintf("SUB%d/CB", i)})
+		assert.NoError(t, err)
+		if assert.Len(t, hierarchy.Hierarchy, 3) {
+			assert.Equal(t, fmt.Sprintf("SUB%d", i), hierarchy.Hierarchy[1].Alias)
+		}
+	}
+}
+
+// TestSessions checks the changes made in a session are only seen in it, with parallel clients in separate sessions
+func TestSessions(t *testing.T) {
+	client, loaded := startTestServer(t)
+	ctx := context.Background()
+
+	ids := make([]string, testSubstations)
+	sessions := make([]context.Context, testSubstations)
+	for i := range sessions {
+		response, err := client.OpenSession(ctx, &pb.OpenSessionRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, response.Error)
+		ids[i] = response.SessionId
+		sessions[i] = metadata.AppendToOutgoingContext(ctx, pb.SessionMetadataKey, ids[i])
+	}
+
+	// Each session renames every CB, and only ever sees its own names
+	var wg sync.WaitGroup
+	for i := range sessions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < testSubstations; j++ {
+				cb := fmt.Sprintf("SUB%d/CB", j)
+				response, err := client.RenameComponent(sessions[i], &pb.RenameComponentRequest{Alias: cb, NewName: fmt.Sprintf("S%d", i)})
+				assert.NoError(t, err)
+				assert.Empty(t, response.Error)
+				info, err := client.GetComponentInfo(sessions[i], &pb.ComponentAlias{Alias: cb})
+				assert.NoError(t, err)
+				assert.Equal(t, fmt.Sprintf("S%d", i), info.CompInfo.Path)
+				assert.Equal(t, fmt.Sprintf("SUB%d", j), getPath(t, client, fmt.Sprintf("SUB%d", j)))
+			}
+			// Rolling back all only undoes the session's changes
+			changes, err := client.GetNumberOfChanges(sessions[i], &pb.GetNumberOfChangesRequest{})
+			assert.NoError(t, err)
+			assert.Equal(t, int32(testSubstations), changes.NumberOfChanges)
+			_, err = client.RollbackAll(sessions[i], &pb.RollbackAllRequest{})
+			assert.NoError(t, err)
+			info, err := client.GetComponentInfo(sessions[i], &pb.ComponentAlias{Alias: "SUB0/CB"})
+			assert.NoError(t, err)
+			assert.Equal(t, "CB", info.CompInfo.Path)
+		}(i)
+	}
+	wg.Wait()
+
+	changes, err := client.GetNumberOfChanges(ctx, &pb.GetNumberOfChangesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(loaded), changes.NumberOfChanges)
+
+	// A closed session can not be used
+	response, err := client.CloseSession(ctx, &pb.CloseSessionRequest{SessionId: ids[0]})
+	assert.NoError(t, err)
+	assert.Empty(t, response.Error)
+	_, err = client.GetNumberOfChanges(sessions[0], &pb.GetNumberOfChangesRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestGetSubtree streams a filtered subtree in batches, in the session it is called in
+func TestGetSubtree(t *testing.T) {
+	defer func(size int) { subtreeBatchSize = size }(subtreeBatchSize)
+	subtreeBatchSize = 3
+	client, _ := startTestServer(t)
+	ctx := context.Background()
+
+	getSubtree := func(ctx context.Context, req *pb.GetSubtreeRequest) ([]string, string) {
+		stream, err := client.GetSubtree(ctx, req)
+		assert.NoError(t, err)
+		aliases := []string{}
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return aliases, ""
+			}
+			if !assert.NoError(t, err) {
+				return aliases, ""
+			}
+			if response.Error != "" {
+				return aliases, response.Error
+			}
+			aliases = append(aliases, response.Component.CompInfo.Alias)
+		}
+	}
+
+	aliases, errMsg := getSubtree(ctx, &pb.GetSubtreeRequest{Alias: "ROOT"})
+	assert.Empty(t, errMsg)
+	assert.Len(t, aliases, 1+2*testSubstations)
+	aliases, _ = getSubtree(ctx, &pb.GetSubtreeRequest{Alias: "ROOT", MaxDepth: 1, Kind: "branch", AliasGlob: "SUB?"})
+	assert.Len(t, aliases, testSubstations)
+	aliases, _ = getSubtree(ctx, &pb.GetSubtreeRequest{Alias: "ROOT", SubstationClass: "Primary Substation Component"})
+	assert.Len(t, aliases, testSubstations)
+	_, errMsg = getSubtree(ctx, &pb.GetSubtreeRequest{Alias: "NONE"})
+	assert.NotEmpty(t, errMsg)
+
+	// A component deleted in a session is only missing from that session's subtree
+	response, err := client.OpenSession(ctx, &pb.OpenSessionRequest{})
+	assert.NoError(t, err)
+	session := metadata.AppendToOutgoingContext(ctx, pb.SessionMetadataKey, response.SessionId)
+	_, err = client.DeleteComponent(session, &pb.DeleteComponentRequest{Alias: "SUB0", Recursive: true})
+	assert.NoError(t, err)
+	aliases, _ = getSubtree(session, &pb.GetSubtreeRequest{Alias: "ROOT", Kind: "leaf"})
+	assert.Equal(t, []string{"SUB1/CB", "SUB2/CB", "SUB3/CB", "SUB4/CB", "SUB5/CB", "SUB6/CB", "SUB7/CB"}, aliases)
+	aliases, _ = getSubtree(ctx, &pb.GetSubtreeRequest{Alias: "ROOT", Kind: "leaf"})
+	assert.Len(t, aliases, testSubstations)
+}
+
+// TestQuery runs a query in a session, returning the rows and the formatted output
+func TestQuery(t *testing.T) {
+	client, _ := startTestServer(t)
+	ctx := context.Background()
+
+	response, err := client.OpenSession(ctx, &pb.OpenSessionRequest{})
+	assert.NoError(t, err)
+	session := metadata.AppendToOutgoingContext(ctx, pb.SessionMetadataKey, response.SessionId)
+	_, err = client.MoveComponent(session, &pb.MoveComponentRequest{Alias: "SUB1/CB", NewLocationAlias: "SUB0"})
+	assert.NoError(t, err)
+
+	query := &pb.QueryRequest{Query: `select alias, parent where under "SUB0"`, Format: "json"}
+	result, err := client.Query(session, query)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Error)
+	assert.Equal(t, []string{"alias", "parent"}, result.Columns)
+	if assert.Len(t, result.Rows, 2) {
+		assert.Equal(t, []string{"SUB1/CB", "SUB0"}, result.Rows[1].Values)
+	}
+	assert.Equal(t, "[\n  {\"alias\": \"SUB0/CB\", \"parent\": \"SUB0\"},\n  {\"alias\": \"SUB1/CB\", \"parent\": \"SUB0\"}\n]\n", result.Output)
+
+	query.Format = "csv"
+	result, err = client.Query(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, "alias,parent\nSUB0/CB,SUB0\n", result.Output)
+
+	result, err = client.Query(ctx, &pb.QueryRequest{Query: `select colour`})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.Error)
+}
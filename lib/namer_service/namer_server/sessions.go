@@ -0,0 +1,101 @@
+package namer_server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/3ideas/psasim/lib/compdb"
+	pb "github.com/3ideas/psasim/lib/namer_service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultSessionTimeout is how long a session can be idle before it is closed
+const DefaultSessionTimeout = 30 * time.Minute
+
+// SetSessionTimeout sets how long a session can be idle before it is closed, 0 keeps sessions until they are closed
+func (s *server) SetSessionTimeout(timeout time.Duration) {
+	s.sessionTimeout = timeout
+}
+
+func (s *server) newGRPCServer() *grpc.Server {
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(s.sessionInterceptor))
+	pb.RegisterNamerServiceServer(grpcServer, s)
+	return grpcServer
+}
+
+// sessionInterceptor runs each call in the session named by its metadata, or the shared changes if it has none
+func (s *server) sessionInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	switch info.FullMethod {
+	case "/namer_service.NamerService/OpenSession", "/namer_service.NamerService/CloseSession":
+		return handler(ctx, req) // these switch sessions themselves
+	}
+
+	var resp interface{}
+	err := s.namer.WithSession(sessionID(ctx), func() error {
+		var err error
+		resp, err = handler(ctx, req)
+		return err
+	})
+	if err := sessionStatus(err); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sessionStatus returns the gRPC status of an error switching to a call's session, other errors are returned as is
+func sessionStatus(err error) error {
+	switch {
+	case errors.Is(err, compdb.ErrUnknownSession):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, compdb.ErrSessionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+// sessionID returns the session ID in the call's metadata, empty if it has none
+func sessionID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(pb.SessionMetadataKey); len(ids) > 0 {
+			return ids[0]
+		}
+	}
+	return ""
+}
+
+// inSession runs an HTTP handler in the session named by the Session-Id header, or the shared changes if it is not set
+func (s *server) inSession(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := s.namer.WithSession(r.Header.Get("Session-Id"), func() error {
+			handler(w, r)
+			return nil
+		})
+		switch {
+		case errors.Is(err, compdb.ErrSessionConflict):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusNotFound)
+		}
+	}
+}
+
+// expireSessions closes idle sessions, checking a few times per timeout
+func (s *server) expireSessions() {
+	if s.sessionTimeout <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.sessionTimeout / 4)
+	defer ticker.Stop()
+	for range ticker.C {
+		if expired := s.namer.ExpireSessions(s.sessionTimeout); len(expired) > 0 {
+			slog.Info("Name server: sessions expired", "sessions", expired)
+		}
+	}
+}
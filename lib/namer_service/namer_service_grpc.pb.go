@@ -25,16 +25,31 @@ type NamerServiceClient interface {
 	CreateAttribute(ctx context.Context, in *CreateAttributeRequest, opts ...grpc.CallOption) (*CreateAttributeResponse, error)
 	UpdateAttribute(ctx context.Context, in *UpdateAttributeRequest, opts ...grpc.CallOption) (*UpdateAttributeResponse, error)
 	CreateComponent(ctx context.Context, in *CreateComponentRequest, opts ...grpc.CallOption) (*CreateComponentResponse, error)
+	DeleteComponent(ctx context.Context, in *DeleteComponentRequest, opts ...grpc.CallOption) (*DeleteComponentResponse, error)
+	DeleteAttribute(ctx context.Context, in *DeleteAttributeRequest, opts ...grpc.CallOption) (*DeleteAttributeResponse, error)
 	RollbackAll(ctx context.Context, in *RollbackAllRequest, opts ...grpc.CallOption) (*RollbackAllResponse, error)
 	GetNumberOfChanges(ctx context.Context, in *GetNumberOfChangesRequest, opts ...grpc.CallOption) (*GetNumberOfChangesResponse, error)
 	GetAttributeValue(ctx context.Context, in *GetAttributeValueRequest, opts ...grpc.CallOption) (*GetAttributeValueResponse, error)
 	GetComponentClass(ctx context.Context, in *GetComponentClassRequest, opts ...grpc.CallOption) (*GetComponentClassResponse, error)
 	SetRollbackPoint(ctx context.Context, in *SetRollbackPointRequest, opts ...grpc.CallOption) (*SetRollbackPointResponse, error)
 	RollbackToPoint(ctx context.Context, in *RollbackToPointRequest, opts ...grpc.CallOption) (*RollbackToPointResponse, error)
+	SetSavepoint(ctx context.Context, in *SetSavepointRequest, opts ...grpc.CallOption) (*SetSavepointResponse, error)
+	RollbackToSavepoint(ctx context.Context, in *RollbackToSavepointRequest, opts ...grpc.CallOption) (*RollbackToSavepointResponse, error)
+	ReleaseSavepoint(ctx context.Context, in *ReleaseSavepointRequest, opts ...grpc.CallOption) (*ReleaseSavepointResponse, error)
+	GetSavepoints(ctx context.Context, in *GetSavepointsRequest, opts ...grpc.CallOption) (*GetSavepointsResponse, error)
+	Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error)
 	GetComponentByID(ctx context.Context, in *ComponentID, opts ...grpc.CallOption) (*ComponentInfoResponse, error)
 	GetChildrenInfoByID(ctx context.Context, in *ComponentID, opts ...grpc.CallOption) (*GetChildrenByIDResponse, error)
 	GetComponentInfo(ctx context.Context, in *ComponentAlias, opts ...grpc.CallOption) (*ComponentInfoResponse, error)
 	GetHierarchyByAlias(ctx context.Context, in *GetHierarchyByAliasRequest, opts ...grpc.CallOption) (*GetHierarchyByAliasResponse, error)
+	ExplainName(ctx context.Context, in *ComponentAlias, opts ...grpc.CallOption) (*ExplainNameResponse, error)
+	PreviewChanges(ctx context.Context, in *PreviewChangesRequest, opts ...grpc.CallOption) (*PreviewChangesResponse, error)
+	FindComponentsByName(ctx context.Context, in *FindComponentsByNameRequest, opts ...grpc.CallOption) (*FindComponentsByNameResponse, error)
+	GetChangeScript(ctx context.Context, in *GetChangeScriptRequest, opts ...grpc.CallOption) (*GetChangeScriptResponse, error)
+	GetSubtree(ctx context.Context, in *GetSubtreeRequest, opts ...grpc.CallOption) (NamerService_GetSubtreeClient, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error)
+	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
 }
 
 type namerServiceClient struct {
@@ -108,6 +123,24 @@ func (c *namerServiceClient) CreateComponent(ctx context.Context, in *CreateComp
 	return out, nil
 }
 
+func (c *namerServiceClient) DeleteComponent(ctx context.Context, in *DeleteComponentRequest, opts ...grpc.CallOption) (*DeleteComponentResponse, error) {
+	out := new(DeleteComponentResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/DeleteComponent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) DeleteAttribute(ctx context.Context, in *DeleteAttributeRequest, opts ...grpc.CallOption) (*DeleteAttributeResponse, error) {
+	out := new(DeleteAttributeResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/DeleteAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *namerServiceClient) RollbackAll(ctx context.Context, in *RollbackAllRequest, opts ...grpc.CallOption) (*RollbackAllResponse, error) {
 	out := new(RollbackAllResponse)
 	err := c.cc.Invoke(ctx, "/namer_service.NamerService/RollbackAll", in, out, opts...)
@@ -162,6 +195,51 @@ func (c *namerServiceClient) RollbackToPoint(ctx context.Context, in *RollbackTo
 	return out, nil
 }
 
+func (c *namerServiceClient) SetSavepoint(ctx context.Context, in *SetSavepointRequest, opts ...grpc.CallOption) (*SetSavepointResponse, error) {
+	out := new(SetSavepointResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/SetSavepoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) RollbackToSavepoint(ctx context.Context, in *RollbackToSavepointRequest, opts ...grpc.CallOption) (*RollbackToSavepointResponse, error) {
+	out := new(RollbackToSavepointResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/RollbackToSavepoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) ReleaseSavepoint(ctx context.Context, in *ReleaseSavepointRequest, opts ...grpc.CallOption) (*ReleaseSavepointResponse, error) {
+	out := new(ReleaseSavepointResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/ReleaseSavepoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) GetSavepoints(ctx context.Context, in *GetSavepointsRequest, opts ...grpc.CallOption) (*GetSavepointsResponse, error) {
+	out := new(GetSavepointsResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/GetSavepoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error) {
+	out := new(RedoResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/Redo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *namerServiceClient) GetComponentByID(ctx context.Context, in *ComponentID, opts ...grpc.CallOption) (*ComponentInfoResponse, error) {
 	out := new(ComponentInfoResponse)
 	err := c.cc.Invoke(ctx, "/namer_service.NamerService/GetComponentByID", in, out, opts...)
@@ -198,6 +276,101 @@ func (c *namerServiceClient) GetHierarchyByAlias(ctx context.Context, in *GetHie
 	return out, nil
 }
 
+func (c *namerServiceClient) ExplainName(ctx context.Context, in *ComponentAlias, opts ...grpc.CallOption) (*ExplainNameResponse, error) {
+	out := new(ExplainNameResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/ExplainName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) PreviewChanges(ctx context.Context, in *PreviewChangesRequest, opts ...grpc.CallOption) (*PreviewChangesResponse, error) {
+	out := new(PreviewChangesResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/PreviewChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) FindComponentsByName(ctx context.Context, in *FindComponentsByNameRequest, opts ...grpc.CallOption) (*FindComponentsByNameResponse, error) {
+	out := new(FindComponentsByNameResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/FindComponentsByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) GetChangeScript(ctx context.Context, in *GetChangeScriptRequest, opts ...grpc.CallOption) (*GetChangeScriptResponse, error) {
+	out := new(GetChangeScriptResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/GetChangeScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) GetSubtree(ctx context.Context, in *GetSubtreeRequest, opts ...grpc.CallOption) (NamerService_GetSubtreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NamerService_ServiceDesc.Streams[0], "/namer_service.NamerService/GetSubtree", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &namerServiceGetSubtreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NamerService_GetSubtreeClient interface {
+	Recv() (*GetSubtreeResponse, error)
+	grpc.ClientStream
+}
+
+type namerServiceGetSubtreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *namerServiceGetSubtreeClient) Recv() (*GetSubtreeResponse, error) {
+	m := new(GetSubtreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *namerServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error) {
+	out := new(OpenSessionResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/OpenSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namerServiceClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error) {
+	out := new(CloseSessionResponse)
+	err := c.cc.Invoke(ctx, "/namer_service.NamerService/CloseSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NamerServiceServer is the server API for NamerService service.
 // All implementations must embed UnimplementedNamerServiceServer
 // for forward compatibility
@@ -209,16 +382,31 @@ type NamerServiceServer interface {
 	CreateAttribute(context.Context, *CreateAttributeRequest) (*CreateAttributeResponse, error)
 	UpdateAttribute(context.Context, *UpdateAttributeRequest) (*UpdateAttributeResponse, error)
 	CreateComponent(context.Context, *CreateComponentRequest) (*CreateComponentResponse, error)
+	DeleteComponent(context.Context, *DeleteComponentRequest) (*DeleteComponentResponse, error)
+	DeleteAttribute(context.Context, *DeleteAttributeRequest) (*DeleteAttributeResponse, error)
 	RollbackAll(context.Context, *RollbackAllRequest) (*RollbackAllResponse, error)
 	GetNumberOfChanges(context.Context, *GetNumberOfChangesRequest) (*GetNumberOfChangesResponse, error)
 	GetAttributeValue(context.Context, *GetAttributeValueRequest) (*GetAttributeValueResponse, error)
 	GetComponentClass(context.Context, *GetComponentClassRequest) (*GetComponentClassResponse, error)
 	SetRollbackPoint(context.Context, *SetRollbackPointRequest) (*SetRollbackPointResponse, error)
 	RollbackToPoint(context.Context, *RollbackToPointRequest) (*RollbackToPointResponse, error)
+	SetSavepoint(context.Context, *SetSavepointRequest) (*SetSavepointResponse, error)
+	RollbackToSavepoint(context.Context, *RollbackToSavepointRequest) (*RollbackToSavepointResponse, error)
+	ReleaseSavepoint(context.Context, *ReleaseSavepointRequest) (*ReleaseSavepointResponse, error)
+	GetSavepoints(context.Context, *GetSavepointsRequest) (*GetSavepointsResponse, error)
+	Redo(context.Context, *RedoRequest) (*RedoResponse, error)
 	GetComponentByID(context.Context, *ComponentID) (*ComponentInfoResponse, error)
 	GetChildrenInfoByID(context.Context, *ComponentID) (*GetChildrenByIDResponse, error)
 	GetComponentInfo(context.Context, *ComponentAlias) (*ComponentInfoResponse, error)
 	GetHierarchyByAlias(context.Context, *GetHierarchyByAliasRequest) (*GetHierarchyByAliasResponse, error)
+	ExplainName(context.Context, *ComponentAlias) (*ExplainNameResponse, error)
+	PreviewChanges(context.Context, *PreviewChangesRequest) (*PreviewChangesResponse, error)
+	FindComponentsByName(context.Context, *FindComponentsByNameRequest) (*FindComponentsByNameResponse, error)
+	GetChangeScript(context.Context, *GetChangeScriptRequest) (*GetChangeScriptResponse, error)
+	GetSubtree(*GetSubtreeRequest, NamerService_GetSubtreeServer) error
+	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error)
+	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
 	mustEmbedUnimplementedNamerServiceServer()
 }
 
@@ -247,6 +435,12 @@ func (UnimplementedNamerServiceServer) UpdateAttribute(context.Context, *UpdateA
 func (UnimplementedNamerServiceServer) CreateComponent(context.Context, *CreateComponentRequest) (*CreateComponentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComponent not implemented")
 }
+func (UnimplementedNamerServiceServer) DeleteComponent(context.Context, *DeleteComponentRequest) (*DeleteComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComponent not implemented")
+}
+func (UnimplementedNamerServiceServer) DeleteAttribute(context.Context, *DeleteAttributeRequest) (*DeleteAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttribute not implemented")
+}
 func (UnimplementedNamerServiceServer) RollbackAll(context.Context, *RollbackAllRequest) (*RollbackAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackAll not implemented")
 }
@@ -265,6 +459,21 @@ func (UnimplementedNamerServiceServer) SetRollbackPoint(context.Context, *SetRol
 func (UnimplementedNamerServiceServer) RollbackToPoint(context.Context, *RollbackToPointRequest) (*RollbackToPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackToPoint not implemented")
 }
+func (UnimplementedNamerServiceServer) SetSavepoint(context.Context, *SetSavepointRequest) (*SetSavepointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSavepoint not implemented")
+}
+func (UnimplementedNamerServiceServer) RollbackToSavepoint(context.Context, *RollbackToSavepointRequest) (*RollbackToSavepointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackToSavepoint not implemented")
+}
+func (UnimplementedNamerServiceServer) ReleaseSavepoint(context.Context, *ReleaseSavepointRequest) (*ReleaseSavepointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSavepoint not implemented")
+}
+func (UnimplementedNamerServiceServer) GetSavepoints(context.Context, *GetSavepointsRequest) (*GetSavepointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavepoints not implemented")
+}
+func (UnimplementedNamerServiceServer) Redo(context.Context, *RedoRequest) (*RedoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redo not implemented")
+}
 func (UnimplementedNamerServiceServer) GetComponentByID(context.Context, *ComponentID) (*ComponentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComponentByID not implemented")
 }
@@ -277,6 +486,30 @@ func (UnimplementedNamerServiceServer) GetComponentInfo(context.Context, *Compon
 func (UnimplementedNamerServiceServer) GetHierarchyByAlias(context.Context, *GetHierarchyByAliasRequest) (*GetHierarchyByAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHierarchyByAlias not implemented")
 }
+func (UnimplementedNamerServiceServer) ExplainName(context.Context, *ComponentAlias) (*ExplainNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainName not implemented")
+}
+func (UnimplementedNamerServiceServer) PreviewChanges(context.Context, *PreviewChangesRequest) (*PreviewChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewChanges not implemented")
+}
+func (UnimplementedNamerServiceServer) FindComponentsByName(context.Context, *FindComponentsByNameRequest) (*FindComponentsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindComponentsByName not implemented")
+}
+func (UnimplementedNamerServiceServer) GetChangeScript(context.Context, *GetChangeScriptRequest) (*GetChangeScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeScript not implemented")
+}
+func (UnimplementedNamerServiceServer) GetSubtree(*GetSubtreeRequest, NamerService_GetSubtreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSubtree not implemented")
+}
+func (UnimplementedNamerServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedNamerServiceServer) OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (UnimplementedNamerServiceServer) CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
+}
 func (UnimplementedNamerServiceServer) mustEmbedUnimplementedNamerServiceServer() {}
 
 // UnsafeNamerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -416,6 +649,42 @@ func _NamerService_CreateComponent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamerService_DeleteComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).DeleteComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/DeleteComponent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).DeleteComponent(ctx, req.(*DeleteComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_DeleteAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).DeleteAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/DeleteAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).DeleteAttribute(ctx, req.(*DeleteAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NamerService_RollbackAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RollbackAllRequest)
 	if err := dec(in); err != nil {
@@ -524,6 +793,96 @@ func _NamerService_RollbackToPoint_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamerService_SetSavepoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSavepointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).SetSavepoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/SetSavepoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).SetSavepoint(ctx, req.(*SetSavepointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_RollbackToSavepoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackToSavepointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).RollbackToSavepoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/RollbackToSavepoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).RollbackToSavepoint(ctx, req.(*RollbackToSavepointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_ReleaseSavepoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSavepointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).ReleaseSavepoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/ReleaseSavepoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).ReleaseSavepoint(ctx, req.(*ReleaseSavepointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_GetSavepoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavepointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).GetSavepoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/GetSavepoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).GetSavepoints(ctx, req.(*GetSavepointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).Redo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/Redo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).Redo(ctx, req.(*RedoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NamerService_GetComponentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ComponentID)
 	if err := dec(in); err != nil {
@@ -596,6 +955,153 @@ func _NamerService_GetHierarchyByAlias_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamerService_ExplainName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComponentAlias)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).ExplainName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/ExplainName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).ExplainName(ctx, req.(*ComponentAlias))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_PreviewChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).PreviewChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/PreviewChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).PreviewChanges(ctx, req.(*PreviewChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_FindComponentsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindComponentsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).FindComponentsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/FindComponentsByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).FindComponentsByName(ctx, req.(*FindComponentsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_GetChangeScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).GetChangeScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/GetChangeScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).GetChangeScript(ctx, req.(*GetChangeScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_GetSubtree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSubtreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NamerServiceServer).GetSubtree(m, &namerServiceGetSubtreeServer{stream})
+}
+
+type NamerService_GetSubtreeServer interface {
+	Send(*GetSubtreeResponse) error
+	grpc.ServerStream
+}
+
+type namerServiceGetSubtreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *namerServiceGetSubtreeServer) Send(m *GetSubtreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NamerService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/OpenSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).OpenSession(ctx, req.(*OpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamerService_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamerServiceServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/namer_service.NamerService/CloseSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamerServiceServer).CloseSession(ctx, req.(*CloseSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NamerService_ServiceDesc is the grpc.ServiceDesc for NamerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -631,6 +1137,14 @@ var NamerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateComponent",
 			Handler:    _NamerService_CreateComponent_Handler,
 		},
+		{
+			MethodName: "DeleteComponent",
+			Handler:    _NamerService_DeleteComponent_Handler,
+		},
+		{
+			MethodName: "DeleteAttribute",
+			Handler:    _NamerService_DeleteAttribute_Handler,
+		},
 		{
 			MethodName: "RollbackAll",
 			Handler:    _NamerService_RollbackAll_Handler,
@@ -655,6 +1169,26 @@ var NamerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RollbackToPoint",
 			Handler:    _NamerService_RollbackToPoint_Handler,
 		},
+		{
+			MethodName: "SetSavepoint",
+			Handler:    _NamerService_SetSavepoint_Handler,
+		},
+		{
+			MethodName: "RollbackToSavepoint",
+			Handler:    _NamerService_RollbackToSavepoint_Handler,
+		},
+		{
+			MethodName: "ReleaseSavepoint",
+			Handler:    _NamerService_ReleaseSavepoint_Handler,
+		},
+		{
+			MethodName: "GetSavepoints",
+			Handler:    _NamerService_GetSavepoints_Handler,
+		},
+		{
+			MethodName: "Redo",
+			Handler:    _NamerService_Redo_Handler,
+		},
 		{
 			MethodName: "GetComponentByID",
 			Handler:    _NamerService_GetComponentByID_Handler,
@@ -671,7 +1205,41 @@ var NamerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHierarchyByAlias",
 			Handler:    _NamerService_GetHierarchyByAlias_Handler,
 		},
+		{
+			MethodName: "ExplainName",
+			Handler:    _NamerService_ExplainName_Handler,
+		},
+		{
+			MethodName: "PreviewChanges",
+			Handler:    _NamerService_PreviewChanges_Handler,
+		},
+		{
+			MethodName: "FindComponentsByName",
+			Handler:    _NamerService_FindComponentsByName_Handler,
+		},
+		{
+			MethodName: "GetChangeScript",
+			Handler:    _NamerService_GetChangeScript_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _NamerService_Query_Handler,
+		},
+		{
+			MethodName: "OpenSession",
+			Handler:    _NamerService_OpenSession_Handler,
+		},
+		{
+			MethodName: "CloseSession",
+			Handler:    _NamerService_CloseSession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetSubtree",
+			Handler:       _NamerService_GetSubtree_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lib/namer_service/namer_service.proto",
 }
@@ -0,0 +1,5 @@
+package namer_service
+
+// SessionMetadataKey is the gRPC metadata key holding the session ID of a call, see OpenSession.
+// Calls without it see the shared changes made outside any session.
+const SessionMetadataKey = "session-id"
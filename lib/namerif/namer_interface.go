@@ -5,21 +5,34 @@ import "github.com/3ideas/psasim/lib/compdb"
 type NameService interface {
 	GetName(alias string) (*compdb.NameDetails, error)
 	GetNameWithHierarchy(alias string) (*compdb.NameWithHierachy, error)
+	ExplainName(alias string) (*compdb.NameExplanation, error)
+	FindComponentsByName(name string, fuzzy bool, maxResults int) ([]*compdb.NameMatch, error)
 	RenameComponent(alias, newName string) error
 	MoveComponent(alias, newLocationAlias string) error
 	CreateAttribute(alias, attrName, attrValue string) error
 	UpdateAttribute(alias, attrName, attrValue string) error
 	CreateComponent(alias, name, parentAlias, templateAlias, substationClassName string) error
+	DeleteComponent(alias string, recursive bool) error
+	DeleteAttribute(alias, attrName string) error
 	RollbackAll() error
 	GetNumberOfChanges() (int, error)
+	ChangeScript() ([]string, error)
 	GetAttributeValue(alias, attrName string) (compdb.AttributeValue, error)
 	GetComponentClassDetails(alias string) (*compdb.ComponentClassDetails, error)
 	SetRollbackPoint() error
 	RollbackToPoint() error
+	SetSavepoint(name string) error
+	RollbackToSavepoint(name string) error
+	ReleaseSavepoint(name string) error
+	Savepoints() ([]compdb.Savepoint, error)
+	Redo() error
+	PreviewChanges(changes []compdb.Change) ([]*compdb.NameImpact, error)
 	// GetComponentInfoByAlias(alias string) (*namer.ComponentInfo, error)
 	GetComponentInfoByID(id string) (*compdb.ComponentInfo, error)
 	GetComponentInfo(alias string) (*compdb.ComponentInfo, error)
 	GetChildrenInfoByID(id string) ([]*compdb.ComponentInfo, error)
+	Query(query string) (*compdb.QueryResult, error)
+	GetSubtree(alias string, maxDepth int, filter compdb.SubtreeFilter) ([]*compdb.SubtreeComponent, error)
 
 	GetHierarchyByAlias(alias string) (compdb.Hierarchy, error)
 }
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"os"
+	"strconv"
 
 	"github.com/3ideas/psasim/lib/compare"
 	"github.com/3ideas/psasim/lib/compdb"
+	"github.com/3ideas/psasim/lib/csvutil"
 	"github.com/3ideas/psasim/lib/loglevel"
 	"github.com/3ideas/psasim/lib/namer_service/namer_client"
 	"github.com/3ideas/psasim/lib/namer_service/namer_server"
@@ -22,10 +25,32 @@ func main() {
 
 	psalertsFile := flag.String("psalerts", "", "PSAlerts CSV file")
 	dbFile := flag.String("db", "", "database file")
+	snapshot := flag.String("snapshot", "", "snapshot file of the loaded -db, used instead of reading -db when it is up to date and rewritten when not")
 	server := flag.Bool("server", false, "run as server")
+	sessionTimeout := flag.Duration("sessiontimeout", namer_server.DefaultSessionTimeout, "close name server sessions idle for this long, 0 keeps them until the client closes them")
+	sessionChanges := flag.Int("sessionchanges", compdb.DefaultMaxSessionChanges, "number of changes a name server session can have, 0 for no limit")
 	useNameService := flag.Bool("usenameservice", false, "use name service for name resolution (rather than dn)")
+	session := flag.String("session", "", "name server session to work in, it is left open, instead of a new session closed on exit")
 	checkaliases := flag.Bool("checkaliases", false, "check aliases")
+	attributesFile := flag.String("attributesfile", "", "file of attribute names to preload, one per line (default is the built in list)")
+	attributeCache := flag.Int("attributecache", compdb.DefaultAttributeCacheSize, "number of attributes looked up on demand to cache, 0 disables on demand lookups")
+	patch := flag.String("patch", "", "component patch number to overlay on the database, or \"all\" to apply every patch in order")
+	patchReport := flag.String("patchreport", "", "write the hierarchy, path and name changes made by -patch to file")
 	dumpNames := flag.String("dumpnames", "", "dump names to file")
+	nameCollisions := flag.String("namecollisions", "", "write a report of generated names shared by more than one alias to file")
+	ruleOverrides := flag.String("ruleoverrides", "", "name rule overrides file (.yaml/.yml or CSV) applied on top of the database name rules")
+	nameChanges := flag.String("namechanges", "", "write the names changed by the name rule overrides to file")
+	compareDb := flag.String("comparedb", "", "previous data load to compare the generated names of -db against")
+	nameDiff := flag.String("namediff", "", "write the differences found by -comparedb to file")
+	lintRules := flag.String("lintrules", "", "check the name rules and write the issues to file (.json for JSON, otherwise CSV)")
+	constraintsFile := flag.String("constraints", "", "YAML file of alias, pathname and generated name constraints changes are checked against, or \"default\" for the built in set (changes are not checked if not set)")
+	placementPolicy := flag.String("placementpolicy", "", "YAML file of the class and substation class placements allowed when moving components")
+	validateNames := flag.String("validatenames", "", "check every alias, pathname and generated name against the constraints, the built in set if -constraints is not given, and write the violations to file")
+	changeScript := flag.String("changescript", "", "write the changes made in the name server -session as a PowerOn script to file")
+	explainName := flag.String("explain", "", "explain how the name is generated for the alias")
+	query := flag.String("query", "", "run a component query, e.g. 'select alias, name where class = \"Circuit\" and under \"SUBX\"'")
+	queryOut := flag.String("queryout", "", "write the -query result to file (.json for JSON, otherwise CSV), default CSV to stdout")
+	tracedNaming := flag.Bool("tracednaming", false, "use traced name rules for components whose class has no name rule")
 
 	comparisonFile := flag.String("comparisonfile", "", "comparison file")
 	resolvedAlarmsFile := flag.String("resolvedalarmsfile", "", "resolved alarms file")
@@ -33,6 +58,18 @@ func main() {
 
 	flag.Parse()
 
+	for _, dbFlag := range []struct{ name, value string }{
+		{"dumpnames", *dumpNames}, {"namecollisions", *nameCollisions}, {"comparedb", *compareDb},
+		{"lintrules", *lintRules}, {"validatenames", *validateNames},
+	} {
+		if dbFlag.value != "" && *dbFile == "" {
+			log.Fatalf("-%s reports on the database, give it with -db", dbFlag.name)
+		}
+	}
+	if *changeScript != "" && (*session == "" || !*useNameService) {
+		log.Fatal("-changescript writes the changes made in a name server session, give the session with -usenameservice -session")
+	}
+
 	logFile := loglevel.SetLogger(*logFilename, *logLevel)
 	if logFile != nil {
 		defer logFile.Close()
@@ -49,18 +86,137 @@ func main() {
 		}
 	}
 
+	loadOptions := compdb.DefaultLoadOptions()
+	loadOptions.AttributeCacheSize = *attributeCache
+	if *attributesFile != "" {
+		loadOptions.AttributeNames, err = csvutil.ReadNameList(*attributesFile)
+		if err != nil {
+			log.Fatal("Error reading attributes file:", err)
+		}
+	}
+	switch *patch {
+	case "":
+	case "all":
+		loadOptions.Patch = compdb.AllPatches
+	default:
+		loadOptions.Patch, err = strconv.Atoi(*patch)
+		if err != nil || loadOptions.Patch <= 0 {
+			log.Fatal("Invalid patch number:", *patch)
+		}
+	}
+	var overrides *compdb.NameRuleOverrides
+	if *ruleOverrides != "" {
+		overrides, err = compdb.ReadNameRuleOverrides(*ruleOverrides)
+		if err != nil {
+			log.Fatal("Error reading name rule overrides:", err)
+		}
+	}
+
 	var compDb *compdb.ComponentDb
 	if *dbFile != "" {
-		compDb, err = compdb.ReadDB(*dbFile)
+		if *snapshot != "" {
+			compDb, err = compdb.ReadDBWithSnapshot(*dbFile, *snapshot, loadOptions)
+		} else {
+			compDb, err = compdb.ReadDBWithOptions(*dbFile, loadOptions)
+		}
 		if err != nil {
 			log.Fatal("Error reading database:", err)
 		}
+		if *patchReport != "" {
+			changes := compDb.PatchReport()
+			if err := compdb.WritePatchReport(*patchReport, changes); err != nil {
+				log.Fatal("Error writing patch report:", err)
+			}
+			fmt.Printf("Patches changed %d components\n", len(changes))
+		}
+		changes, err := applyNaming(compDb, *tracedNaming, overrides)
+		if err != nil {
+			log.Fatal("Error applying name rule overrides:", err)
+		}
+		if overrides != nil {
+			fmt.Printf("Name rule overrides changed %d names\n", len(changes))
+			if *nameChanges != "" {
+				if err := compdb.WriteNameChanges(*nameChanges, changes); err != nil {
+					log.Fatal("Error writing name changes:", err)
+				}
+			}
+		}
+		compDb.SetMaxSessionChanges(*sessionChanges)
+		if *placementPolicy != "" {
+			policy, err := compdb.ReadPlacementPolicy(*placementPolicy)
+			if err != nil {
+				log.Fatal("Error reading placement policy:", err)
+			}
+			compDb.SetPlacementPolicy(policy)
+		}
+		if *constraintsFile != "" {
+			constraints := compdb.DefaultNameConstraints()
+			if *constraintsFile != "default" {
+				constraints, err = compdb.ReadNameConstraints(*constraintsFile)
+				if err != nil {
+					log.Fatal("Error reading name constraints:", err)
+				}
+			}
+			if err := compDb.SetNameConstraints(constraints); err != nil {
+				log.Fatal("Error setting name constraints:", err)
+			}
+		}
 	}
 
 	if *dumpNames != "" {
 		compDb.DumpNames(*dumpNames)
 	}
 
+	if *nameCollisions != "" {
+		collisions, err := compDb.WriteNameCollisions(*nameCollisions)
+		if err != nil {
+			log.Fatal("Error writing name collisions:", err)
+		}
+		shared := 0
+		for _, collision := range collisions {
+			fmt.Print(collision)
+			shared += len(collision.Components)
+		}
+		fmt.Printf("%d names shared by %d components\n", len(collisions), shared)
+	}
+
+	if *compareDb != "" {
+		// The previous load is named the same way, so only the data causes differences
+		oldDb, err := compdb.ReadDBWithOptions(*compareDb, loadOptions)
+		if err != nil {
+			log.Fatal("Error reading database:", err)
+		}
+		if _, err := applyNaming(oldDb, *tracedNaming, overrides); err != nil {
+			log.Fatal("Error applying name rule overrides:", err)
+		}
+		diffs := compdb.DiffNames(oldDb, compDb)
+		if *nameDiff != "" {
+			if err := compdb.WriteNameDiffs(*nameDiff, diffs); err != nil {
+				log.Fatal("Error writing name differences:", err)
+			}
+		}
+		fmt.Print(compdb.NameDiffSummary(diffs))
+	}
+
+	if *lintRules != "" {
+		issues := compDb.LintNameRules()
+		for _, issue := range issues {
+			fmt.Println(issue)
+		}
+		if err := compdb.WriteNameRuleIssues(*lintRules, issues); err != nil {
+			log.Fatal("Error writing name rule issues:", err)
+		}
+		fmt.Print(compdb.NameRuleIssueSummary(issues))
+	}
+
+	if *validateNames != "" {
+		violations := compDb.ValidateNames()
+		if err := compdb.WriteConstraintViolations(*validateNames, violations); err != nil {
+			log.Fatal("Error writing constraint violations:", err)
+		}
+		fmt.Printf("%d name constraint violations\n", len(violations))
+	}
+
 	var alarmComparison *compare.AlarmsComparison
 	var eterraToPO *compare.EterraToPO
 	if *comparisonFile != "" {
@@ -75,6 +231,7 @@ func main() {
 	// Do we need to run the server?
 	if *server && compDb != nil {
 		server := namer_server.NewNameServer(compDb)
+		server.SetSessionTimeout(*sessionTimeout)
 		slog.Info("name server started")
 		fmt.Printf("name server started\n")
 		err := server.StartServer()
@@ -91,30 +248,17 @@ func main() {
 			slog.Error("Error connecting to name server", "Error", err)
 			return
 		}
-		noOfChanges, err := nameserver.GetNumberOfChanges()
-		if err != nil {
-			slog.Error("Error getting number of changes", "Error", err)
-			return
-		}
-		slog.Info("Number of changes", "Number of changes", noOfChanges)
-		fmt.Printf("Number of changes: %d\n", noOfChanges)
-		if noOfChanges > 0 { // rollback any changes
-			err = nameserver.RollbackAll()
-			if err != nil {
-				fmt.Printf("Error rolling back all: %s\n", err)
-				slog.Error("Error rolling back all", "Error", err)
-				return
-			}
-			slog.Info("Undid all")
-			noOfChanges, err = nameserver.GetNumberOfChanges()
-			if err != nil {
-				slog.Error("Error getting number of changes", "Error", err)
+		defer nameserver.Close()
+		if *session != "" {
+			nameserver.UseSession(*session)
+		} else {
+			// Work in a session so the changes made here are not seen by, and do not roll back, other clients
+			if err := nameserver.OpenSession(); err != nil {
+				slog.Error("Error opening name server session", "Error", err)
 				return
 			}
-			slog.Info("Number of changes", "Number of changes", noOfChanges)
-			fmt.Printf("Number of changes after rolling back all: %d\n", noOfChanges)
+			defer nameserver.CloseSession()
 		}
-		defer nameserver.Close()
 	}
 
 	if *useNameService && nameserver == nil {
@@ -137,6 +281,51 @@ func main() {
 		psalerts.CheckAliases(nameChecker)
 	}
 
+	if *explainName != "" {
+		explanation, err := nameChecker.ExplainName(*explainName)
+		if err != nil {
+			fmt.Printf("Error explaining name: %s\n", err)
+			slog.Error("Error explaining name", "Error", err)
+		} else {
+			fmt.Print(explanation)
+		}
+		if alerts == nil {
+			return
+		}
+	}
+
+	if *query != "" {
+		result, err := nameChecker.Query(*query)
+		if err != nil {
+			log.Fatal("Error running query:", err)
+		}
+		if *queryOut != "" {
+			if err := compdb.WriteQueryResult(*queryOut, result); err != nil {
+				log.Fatal("Error writing query result:", err)
+			}
+			fmt.Printf("Wrote %d query rows to %s\n", len(result.Rows), *queryOut)
+		} else if err := result.WriteCSV(os.Stdout); err != nil {
+			log.Fatal("Error writing query result:", err)
+		}
+		if alerts == nil {
+			return
+		}
+	}
+
+	if *changeScript != "" {
+		lines, err := nameChecker.ChangeScript()
+		if err != nil {
+			log.Fatal("Error getting change script:", err)
+		}
+		if err := compdb.WriteChangeScript(*changeScript, lines); err != nil {
+			log.Fatal("Error writing change script:", err)
+		}
+		fmt.Printf("Wrote %d script lines to %s\n", len(lines), *changeScript)
+		if alerts == nil {
+			return
+		}
+	}
+
 	if alerts == nil {
 		fmt.Println("No alarms to process")
 		log.Fatal("No alarms to process")
@@ -153,3 +342,15 @@ func main() {
 		}
 	}
 }
+
+// applyNaming sets the naming options on a loaded ComponentDb, so every database loaded is named the same way.
+// Returns the names changed by the overrides, if any.
+func applyNaming(compDb *compdb.ComponentDb, tracedNaming bool, overrides *compdb.NameRuleOverrides) ([]*compdb.NameChange, error) {
+	if tracedNaming {
+		compDb.SetTracedNaming(true)
+	}
+	if overrides == nil {
+		return nil, nil
+	}
+	return compDb.ApplyNameRuleOverrides(overrides)
+}